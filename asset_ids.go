@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/luxfi/crypto/hash"
+	"github.com/luxfi/ids"
+)
+
+// assetIDTagSuffix follows the brand in every asset ID preimage, as in the
+// legacy "lux asset id" preimage of UTXOAssetIDFor.
+const assetIDTagSuffix = " asset id"
+
+var (
+	ErrInvalidAssetDomain = errors.New("invalid asset domain")
+
+	// wellKnownPrimaryAssets caches UTXOAssetIDFor for every well-known
+	// network. It is only written during init.
+	wellKnownPrimaryAssets = make(map[ids.ID]NetworkID)
+)
+
+func init() {
+	for networkID := range NetworkIDToNetworkName {
+		wellKnownPrimaryAssets[UTXOAssetIDFor(networkID)] = networkID
+	}
+}
+
+// AssetDomain identifies a native asset for AssetIDFor. Two assets get the
+// same ID only if every field matches.
+type AssetDomain struct {
+	// Brand is the ecosystem prefix, PlatformName for Lux. Downstream chains
+	// use their own brand so their asset IDs never collide with Lux's.
+	Brand string
+	// NetworkID is the primary network the asset lives on.
+	NetworkID NetworkID
+	// ChainID is the chain the asset is minted on: XChainID for X-Chain
+	// assets, the blockchain ID for L1s. The primary asset, shared by P-Chain
+	// and X-Chain, may use ids.Empty, PlatformChainID or XChainID.
+	ChainID ids.ID
+	// Symbol is the case-sensitive asset symbol, e.g. "LUX".
+	Symbol string
+}
+
+// PrimaryAssetDomain returns the domain of the primary asset of [networkID].
+func PrimaryAssetDomain(networkID NetworkID) AssetDomain {
+	return AssetDomain{
+		Brand:     PlatformName,
+		NetworkID: networkID,
+		ChainID:   XChainID,
+		Symbol:    NativeCurrencySymbol,
+	}
+}
+
+// IsPrimary reports whether [d] is the primary LUX asset of its network.
+func (d AssetDomain) IsPrimary() bool {
+	if d.Brand != PlatformName || d.Symbol != NativeCurrencySymbol {
+		return false
+	}
+	return d.ChainID == ids.Empty || d.ChainID == PlatformChainID || d.ChainID == XChainID
+}
+
+// AssetIDFor derives the ID of the asset described by [d], generalizing
+// UTXOAssetIDFor to any number of assets per network and chain. The primary
+// asset keeps the UTXOAssetIDFor derivation, so mainnet LUX is still the
+// UTXO_ASSET_ID literal.
+//
+// Layout of the preimage for every other asset: brand || " asset id" ||
+// 0x00 || 4-byte big-endian networkID || 32-byte chainID || 1-byte symbol
+// length || symbol. The brand can't contain a zero byte, so the separator
+// and the length prefix keep the encoding injective. Hashed with SHA-256.
+func AssetIDFor(d AssetDomain) (ids.ID, error) {
+	if err := d.verify(); err != nil {
+		return ids.Empty, err
+	}
+	if d.IsPrimary() {
+		return UTXOAssetIDFor(d.NetworkID), nil
+	}
+
+	preimage := make([]byte, 0, len(d.Brand)+len(assetIDTagSuffix)+1+4+ids.IDLen+1+len(d.Symbol))
+	preimage = append(preimage, d.Brand...)
+	preimage = append(preimage, assetIDTagSuffix...)
+	preimage = append(preimage, 0)
+	preimage = binary.BigEndian.AppendUint32(preimage, uint32(d.NetworkID))
+	preimage = append(preimage, d.ChainID[:]...)
+	preimage = append(preimage, byte(len(d.Symbol)))
+	preimage = append(preimage, d.Symbol...)
+	return hash.ComputeHash256Array(preimage), nil
+}
+
+func (d AssetDomain) verify() error {
+	switch {
+	case len(d.Brand) == 0:
+		return fmt.Errorf("%w: empty brand", ErrInvalidAssetDomain)
+	case len(d.Symbol) == 0:
+		return fmt.Errorf("%w: empty symbol", ErrInvalidAssetDomain)
+	case len(d.Symbol) > math.MaxUint8:
+		return fmt.Errorf("%w: symbol longer than %d bytes", ErrInvalidAssetDomain, math.MaxUint8)
+	}
+	for i := 0; i < len(d.Brand); i++ {
+		if d.Brand[i] == 0 {
+			return fmt.Errorf("%w: brand contains a zero byte", ErrInvalidAssetDomain)
+		}
+	}
+	return nil
+}
+
+// PrimaryAssetNetwork is the reverse of UTXOAssetIDFor: it returns the
+// network whose primary asset is [assetID]. It returns false if [assetID]
+// isn't the primary asset of any well-known network or of a network
+// registered with the DefaultRegistry.
+func PrimaryAssetNetwork(assetID ids.ID) (NetworkID, bool) {
+	return DefaultRegistry.PrimaryAssetNetwork(assetID)
+}
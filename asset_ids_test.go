@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/luxfi/ids"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetIDForPrimary(t *testing.T) {
+	require := require.New(t)
+
+	for _, chainID := range []ids.ID{ids.Empty, PlatformChainID, XChainID} {
+		d := PrimaryAssetDomain(MainnetID)
+		d.ChainID = chainID
+		assetID, err := AssetIDFor(d)
+		require.NoError(err)
+		require.Equal(UTXO_ASSET_ID, assetID)
+	}
+
+	assetID, err := AssetIDFor(PrimaryAssetDomain(TestnetID))
+	require.NoError(err)
+	require.Equal(UTXOAssetIDFor(TestnetID), assetID)
+}
+
+func TestAssetIDForDomainSeparation(t *testing.T) {
+	require := require.New(t)
+
+	l1ChainID := ids.GenerateTestID()
+	domains := []AssetDomain{
+		PrimaryAssetDomain(MainnetID),
+		PrimaryAssetDomain(TestnetID),
+		{Brand: PlatformName, NetworkID: MainnetID, ChainID: XChainID, Symbol: "USD"},
+		{Brand: PlatformName, NetworkID: TestnetID, ChainID: XChainID, Symbol: "USD"},
+		{Brand: PlatformName, NetworkID: MainnetID, ChainID: l1ChainID, Symbol: "USD"},
+		{Brand: PlatformName, NetworkID: MainnetID, ChainID: l1ChainID, Symbol: NativeCurrencySymbol},
+		{Brand: PlatformName, NetworkID: MainnetID, ChainID: XChainID, Symbol: "usd"},
+		{Brand: "zoo", NetworkID: MainnetID, ChainID: XChainID, Symbol: NativeCurrencySymbol},
+	}
+	seen := make(map[ids.ID]AssetDomain)
+	for _, d := range domains {
+		assetID, err := AssetIDFor(d)
+		require.NoError(err)
+		require.NotContains(seen, assetID, "%+v collides with %+v", d, seen[assetID])
+		seen[assetID] = d
+	}
+}
+
+func TestAssetIDForInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		domain AssetDomain
+	}{
+		{
+			name:   "empty brand",
+			domain: AssetDomain{Symbol: NativeCurrencySymbol},
+		},
+		{
+			name:   "empty symbol",
+			domain: AssetDomain{Brand: PlatformName},
+		},
+		{
+			name:   "long symbol",
+			domain: AssetDomain{Brand: PlatformName, Symbol: strings.Repeat("A", 256)},
+		},
+		{
+			name:   "zero byte in brand",
+			domain: AssetDomain{Brand: "lux\x00", Symbol: NativeCurrencySymbol},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := AssetIDFor(test.domain)
+			require.ErrorIs(t, err, ErrInvalidAssetDomain)
+		})
+	}
+}
+
+func TestPrimaryAssetNetwork(t *testing.T) {
+	require := require.New(t)
+
+	for networkID := range NetworkIDToNetworkName {
+		got, ok := PrimaryAssetNetwork(UTXOAssetIDFor(networkID))
+		require.True(ok)
+		require.Equal(networkID, got)
+	}
+
+	const runtimeID NetworkID = 4242
+	_, ok := PrimaryAssetNetwork(UTXOAssetIDFor(runtimeID))
+	require.False(ok)
+
+	// Registration is scoped to the registry it was made on.
+	r := NewChainRegistry()
+	r.RegisterConfig(&ChainConfig{NetworkID: runtimeID})
+	got, ok := r.PrimaryAssetNetwork(UTXOAssetIDFor(runtimeID))
+	require.True(ok)
+	require.Equal(runtimeID, got)
+	_, ok = PrimaryAssetNetwork(UTXOAssetIDFor(runtimeID))
+	require.False(ok)
+	got, ok = r.PrimaryAssetNetwork(UTXOAssetIDFor(MainnetID))
+	require.True(ok)
+	require.Equal(MainnetID, got)
+
+	_, ok = PrimaryAssetNetwork(ids.GenerateTestID())
+	require.False(ok)
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CAIP-2 namespaces used for Lux chains.
+//
+// See: https://github.com/ChainAgnostic/CAIPs/blob/main/CAIPs/caip-2.md
+const (
+	// CAIP2NamespaceEIP155 identifies EVM chains by their EIP-155 chain ID,
+	// e.g. eip155:96369 for the mainnet C-Chain.
+	CAIP2NamespaceEIP155 = "eip155"
+
+	// CAIP2NamespaceLux identifies the UTXO chains of a primary network by
+	// network name and chain alias, e.g. lux:mainnet-X.
+	CAIP2NamespaceLux = PlatformName
+
+	// CChainAlias, PChainAlias and XChainAlias are the primary-network chain
+	// aliases used in addresses (X-lux1...) and CAIP references.
+	CChainAlias = "C"
+	PChainAlias = "P"
+	XChainAlias = "X"
+
+	caip2Separator       = ":"
+	caipLuxRefSeparator  = "-"
+	caip2MaxReferenceLen = 32
+	caip10MaxAddressLen  = 128
+)
+
+var (
+	ErrInvalidCAIP2  = errors.New("invalid CAIP-2 chain ID")
+	ErrInvalidCAIP10 = errors.New("invalid CAIP-10 account ID")
+
+	// caipUTXOChainAliases are the chains addressed in the lux namespace.
+	caipUTXOChainAliases = map[string]bool{
+		PChainAlias: true,
+		XChainAlias: true,
+	}
+)
+
+// CAIP2ChainID is a CAIP-2 blockchain ID: "<namespace>:<reference>".
+type CAIP2ChainID struct {
+	Namespace string
+	Reference string
+}
+
+func (c CAIP2ChainID) String() string {
+	return c.Namespace + caip2Separator + c.Reference
+}
+
+// CChainCAIP2 returns the CAIP-2 ID of the C-Chain with EIP-155 chain ID
+// [chainID], e.g. eip155:96369.
+func CChainCAIP2(chainID EVMChainID) CAIP2ChainID {
+	return CAIP2ChainID{
+		Namespace: CAIP2NamespaceEIP155,
+		Reference: strconv.FormatUint(uint64(chainID), 10),
+	}
+}
+
+// UTXOChainCAIP2 returns the CAIP-2 ID of the UTXO chain [chainAlias] ("P"
+// or "X") on [networkID], e.g. lux:mainnet-X or lux:network-42-P.
+func UTXOChainCAIP2(networkID NetworkID, chainAlias string) (CAIP2ChainID, error) {
+	if !caipUTXOChainAliases[chainAlias] {
+		return CAIP2ChainID{}, fmt.Errorf("%w: %q", ErrUnknownChain, chainAlias)
+	}
+	return CAIP2ChainID{
+		Namespace: CAIP2NamespaceLux,
+		Reference: NetworkName(networkID) + caipLuxRefSeparator + chainAlias,
+	}, nil
+}
+
+// ParseCAIP2 parses and validates a CAIP-2 chain ID.
+func ParseCAIP2(s string) (CAIP2ChainID, error) {
+	namespace, reference, ok := strings.Cut(s, caip2Separator)
+	if !ok || !validCAIP2Namespace(namespace) || !validCAIP2Reference(reference) {
+		return CAIP2ChainID{}, fmt.Errorf("%w: %q", ErrInvalidCAIP2, s)
+	}
+	return CAIP2ChainID{
+		Namespace: namespace,
+		Reference: reference,
+	}, nil
+}
+
+// Resolve returns the primary network and chain alias that [c] refers to.
+// eip155 IDs resolve through the well-known C-Chain IDs; lux IDs resolve
+// through the network name tables.
+func (c CAIP2ChainID) Resolve() (NetworkID, string, error) {
+	switch c.Namespace {
+	case CAIP2NamespaceEIP155:
+		chainID, err := strconv.ParseUint(c.Reference, 10, 32)
+		if err != nil {
+			return 0, "", fmt.Errorf("%w: %q", ErrInvalidCAIP2, c)
+		}
+		networkID, ok := NetworkIDForEVMChain(EVMChainID(chainID))
+		if !ok {
+			return 0, "", fmt.Errorf("%w: %q", ErrNetworkNotFound, c)
+		}
+		return networkID, CChainAlias, nil
+	case CAIP2NamespaceLux:
+		i := strings.LastIndex(c.Reference, caipLuxRefSeparator)
+		if i < 0 {
+			return 0, "", fmt.Errorf("%w: %q", ErrInvalidCAIP2, c)
+		}
+		networkName, chainAlias := c.Reference[:i], c.Reference[i+1:]
+		if !caipUTXOChainAliases[chainAlias] {
+			return 0, "", fmt.Errorf("%w: %q", ErrUnknownChain, chainAlias)
+		}
+		networkID, err := NetworkIDFromName(networkName)
+		if err != nil {
+			return 0, "", err
+		}
+		return networkID, chainAlias, nil
+	default:
+		return 0, "", fmt.Errorf("%w: unsupported namespace %q", ErrInvalidCAIP2, c.Namespace)
+	}
+}
+
+// CAIP10AccountID is a CAIP-10 account ID: "<CAIP-2 chain ID>:<address>".
+//
+// See: https://github.com/ChainAgnostic/CAIPs/blob/main/CAIPs/caip-10.md
+type CAIP10AccountID struct {
+	Chain   CAIP2ChainID
+	Address string
+}
+
+func (a CAIP10AccountID) String() string {
+	return a.Chain.String() + caip2Separator + a.Address
+}
+
+// NewCAIP10 returns the CAIP-10 ID of [address] on [chain]. UTXO chain
+// addresses are given without their chain prefix ("lux1...", not
+// "X-lux1...").
+func NewCAIP10(chain CAIP2ChainID, address string) (CAIP10AccountID, error) {
+	if !validCAIP10Address(address) {
+		return CAIP10AccountID{}, fmt.Errorf("%w: address %q", ErrInvalidCAIP10, address)
+	}
+	return CAIP10AccountID{
+		Chain:   chain,
+		Address: address,
+	}, nil
+}
+
+// ParseCAIP10 parses and validates a CAIP-10 account ID.
+func ParseCAIP10(s string) (CAIP10AccountID, error) {
+	i := strings.LastIndex(s, caip2Separator)
+	if i < 0 {
+		return CAIP10AccountID{}, fmt.Errorf("%w: %q", ErrInvalidCAIP10, s)
+	}
+	chain, err := ParseCAIP2(s[:i])
+	if err != nil {
+		return CAIP10AccountID{}, fmt.Errorf("%w: %w", ErrInvalidCAIP10, err)
+	}
+	return NewCAIP10(chain, s[i+1:])
+}
+
+// validCAIP2Namespace matches [-a-z0-9]{3,8}.
+func validCAIP2Namespace(s string) bool {
+	if len(s) < 3 || len(s) > 8 {
+		return false
+	}
+	for _, r := range s {
+		if r != '-' && (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// validCAIP2Reference matches [-_a-zA-Z0-9]{1,32}.
+func validCAIP2Reference(s string) bool {
+	if len(s) < 1 || len(s) > caip2MaxReferenceLen {
+		return false
+	}
+	for _, r := range s {
+		if r != '-' && r != '_' && !isASCIIAlphanumeric(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// validCAIP10Address matches [-.%a-zA-Z0-9]{1,128}.
+func validCAIP10Address(s string) bool {
+	if len(s) < 1 || len(s) > caip10MaxAddressLen {
+		return false
+	}
+	for _, r := range s {
+		if r != '-' && r != '.' && r != '%' && !isASCIIAlphanumeric(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCIIAlphanumeric(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCAIP2RoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		chain      func() (CAIP2ChainID, error)
+		expected   string
+		networkID  NetworkID
+		chainAlias string
+	}{
+		{
+			name: "mainnet C-Chain",
+			chain: func() (CAIP2ChainID, error) {
+				return CChainCAIP2(MainnetChainID), nil
+			},
+			expected:   "eip155:96369",
+			networkID:  MainnetID,
+			chainAlias: CChainAlias,
+		},
+		{
+			name: "testnet X-Chain",
+			chain: func() (CAIP2ChainID, error) {
+				return UTXOChainCAIP2(TestnetID, XChainAlias)
+			},
+			expected:   "lux:testnet-X",
+			networkID:  TestnetID,
+			chainAlias: XChainAlias,
+		},
+		{
+			name: "custom P-Chain",
+			chain: func() (CAIP2ChainID, error) {
+				return UTXOChainCAIP2(42, PChainAlias)
+			},
+			expected:   "lux:network-42-P",
+			networkID:  42,
+			chainAlias: PChainAlias,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			chain, err := test.chain()
+			require.NoError(err)
+			require.Equal(test.expected, chain.String())
+
+			parsed, err := ParseCAIP2(test.expected)
+			require.NoError(err)
+			require.Equal(chain, parsed)
+
+			networkID, chainAlias, err := parsed.Resolve()
+			require.NoError(err)
+			require.Equal(test.networkID, networkID)
+			require.Equal(test.chainAlias, chainAlias)
+		})
+	}
+}
+
+func TestCAIP10(t *testing.T) {
+	require := require.New(t)
+
+	const s = "eip155:96369:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	account, err := ParseCAIP10(s)
+	require.NoError(err)
+	require.Equal(CChainCAIP2(MainnetChainID), account.Chain)
+	require.Equal("0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", account.Address)
+	require.Equal(s, account.String())
+
+	_, err = ParseCAIP10("eip155:96369:")
+	require.ErrorIs(err, ErrInvalidCAIP10)
+
+	_, err = ParseCAIP10("e:1:0xab")
+	require.ErrorIs(err, ErrInvalidCAIP10)
+
+	_, err = UTXOChainCAIP2(MainnetID, CChainAlias)
+	require.ErrorIs(err, ErrUnknownChain)
+}
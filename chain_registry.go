@@ -13,7 +13,7 @@ import (
 // This allows chain IDs to be dynamically configured per network,
 // enabling migration to new chain IDs without code changes.
 type ChainConfig struct {
-	NetworkID uint32
+	NetworkID NetworkID
 
 	PChainID ids.ID // Platform chain - staking, validation
 	XChainID ids.ID // Exchange chain - UTXO asset exchange
@@ -33,10 +33,13 @@ type ChainConfig struct {
 // It supports runtime configuration and migration of chain IDs.
 type ChainRegistry struct {
 	mu      sync.RWMutex
-	configs map[uint32]*ChainConfig
+	configs map[NetworkID]*ChainConfig
+	// primaryAssets maps UTXOAssetIDFor of each registered network back to
+	// the network, so PrimaryAssetNetwork never hashes.
+	primaryAssets map[ids.ID]NetworkID
 
 	// Callbacks for chain ID migration events
-	onMigrate []func(networkID uint32, oldConfig, newConfig *ChainConfig)
+	onMigrate []func(networkID NetworkID, oldConfig, newConfig *ChainConfig)
 }
 
 // DefaultRegistry is the global chain registry with default configurations.
@@ -53,20 +56,38 @@ func init() {
 // NewChainRegistry creates a new chain registry.
 func NewChainRegistry() *ChainRegistry {
 	return &ChainRegistry{
-		configs: make(map[uint32]*ChainConfig),
+		configs:       make(map[NetworkID]*ChainConfig),
+		primaryAssets: make(map[ids.ID]NetworkID),
 	}
 }
 
-// RegisterConfig registers a chain configuration for a network.
+// RegisterConfig registers a chain configuration for a network. The
+// network's primary asset becomes resolvable by r.PrimaryAssetNetwork.
 func (r *ChainRegistry) RegisterConfig(config *ChainConfig) {
+	assetID := UTXOAssetIDFor(config.NetworkID)
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.configs[config.NetworkID] = config
+	r.primaryAssets[assetID] = config.NetworkID
+}
+
+// PrimaryAssetNetwork returns the network whose primary asset is [assetID],
+// among the well-known networks and those registered with [r].
+func (r *ChainRegistry) PrimaryAssetNetwork(assetID ids.ID) (NetworkID, bool) {
+	if networkID, ok := wellKnownPrimaryAssets[assetID]; ok {
+		return networkID, true
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	networkID, ok := r.primaryAssets[assetID]
+	return networkID, ok
 }
 
 // GetConfig returns the chain configuration for a network.
 // Returns nil if no configuration exists.
-func (r *ChainRegistry) GetConfig(networkID uint32) *ChainConfig {
+func (r *ChainRegistry) GetConfig(networkID NetworkID) *ChainConfig {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	return r.configs[networkID]
@@ -74,7 +95,7 @@ func (r *ChainRegistry) GetConfig(networkID uint32) *ChainConfig {
 
 // GetOrDefault returns the chain configuration for a network,
 // or the default (mainnet) configuration if not found.
-func (r *ChainRegistry) GetOrDefault(networkID uint32) *ChainConfig {
+func (r *ChainRegistry) GetOrDefault(networkID NetworkID) *ChainConfig {
 	if config := r.GetConfig(networkID); config != nil {
 		return config
 	}
@@ -86,7 +107,7 @@ func (r *ChainRegistry) GetOrDefault(networkID uint32) *ChainConfig {
 
 // MigrateChain updates a chain ID for a network.
 // This triggers migration callbacks and is used for chain upgrades.
-func (r *ChainRegistry) MigrateChain(networkID uint32, chainName string, newChainID ids.ID) error {
+func (r *ChainRegistry) MigrateChain(networkID NetworkID, chainName string, newChainID ids.ID) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -131,7 +152,7 @@ func (r *ChainRegistry) MigrateChain(networkID uint32, chainName string, newChai
 }
 
 // OnMigrate registers a callback for chain migration events.
-func (r *ChainRegistry) OnMigrate(callback func(networkID uint32, oldConfig, newConfig *ChainConfig)) {
+func (r *ChainRegistry) OnMigrate(callback func(networkID NetworkID, oldConfig, newConfig *ChainConfig)) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.onMigrate = append(r.onMigrate, callback)
@@ -140,52 +161,52 @@ func (r *ChainRegistry) OnMigrate(callback func(networkID uint32, oldConfig, new
 // Convenience methods for accessing chain IDs
 
 // GetPChainID returns the P-chain ID for the given network.
-func (r *ChainRegistry) GetPChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetPChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).PChainID
 }
 
 // GetXChainID returns the X-chain ID for the given network.
-func (r *ChainRegistry) GetXChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetXChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).XChainID
 }
 
 // GetCChainID returns the C-chain ID for the given network.
-func (r *ChainRegistry) GetCChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetCChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).CChainID
 }
 
 // GetQChainID returns the Q-chain ID for the given network.
-func (r *ChainRegistry) GetQChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetQChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).QChainID
 }
 
 // GetAChainID returns the A-chain ID for the given network.
-func (r *ChainRegistry) GetAChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetAChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).AChainID
 }
 
 // GetBChainID returns the B-chain ID for the given network.
-func (r *ChainRegistry) GetBChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetBChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).BChainID
 }
 
 // GetMChainID returns the M-chain ID for the given network.
-func (r *ChainRegistry) GetMChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetMChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).MChainID
 }
 
 // GetFChainID returns the F-chain ID for the given network.
-func (r *ChainRegistry) GetFChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetFChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).FChainID
 }
 
 // GetZChainID returns the Z-chain ID for the given network.
-func (r *ChainRegistry) GetZChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetZChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).ZChainID
 }
 
 // GetDChainID returns the D-chain ID for the given network.
-func (r *ChainRegistry) GetDChainID(networkID uint32) ids.ID {
+func (r *ChainRegistry) GetDChainID(networkID NetworkID) ids.ID {
 	return r.GetOrDefault(networkID).DChainID
 }
 
@@ -258,26 +279,26 @@ func defaultCustomConfig() *ChainConfig {
 // Package-level convenience functions using DefaultRegistry
 
 // GetChainConfig returns the chain configuration for a network.
-func GetChainConfig(networkID uint32) *ChainConfig {
+func GetChainConfig(networkID NetworkID) *ChainConfig {
 	return DefaultRegistry.GetOrDefault(networkID)
 }
 
 // GetNetworkPChainID returns the P-chain ID for the given network.
-func GetNetworkPChainID(networkID uint32) ids.ID {
+func GetNetworkPChainID(networkID NetworkID) ids.ID {
 	return DefaultRegistry.GetPChainID(networkID)
 }
 
 // GetNetworkXChainID returns the X-chain ID for the given network.
-func GetNetworkXChainID(networkID uint32) ids.ID {
+func GetNetworkXChainID(networkID NetworkID) ids.ID {
 	return DefaultRegistry.GetXChainID(networkID)
 }
 
 // GetNetworkCChainID returns the C-chain ID for the given network.
-func GetNetworkCChainID(networkID uint32) ids.ID {
+func GetNetworkCChainID(networkID NetworkID) ids.ID {
 	return DefaultRegistry.GetCChainID(networkID)
 }
 
 // GetNetworkQChainID returns the Q-chain ID for the given network.
-func GetNetworkQChainID(networkID uint32) ids.ID {
+func GetNetworkQChainID(networkID NetworkID) ids.ID {
 	return DefaultRegistry.GetQChainID(networkID)
 }
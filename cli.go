@@ -23,12 +23,19 @@ const (
 	NetrunnerLocalNetworkID = LocalID // 1337
 	LocalNetworkNumNodes    = 3
 
-	// Staking constants
-	MinStakeDuration     = 24 * 14 * time.Hour  // 2 weeks
-	MaxStakeDuration     = 24 * 365 * time.Hour // 1 year
-	MinStakeWeight       = uint64(1)
+	// Staking constants. They mirror PrimaryNetworkStakingParams; validate
+	// stakes with ValidateStake, which picks the params of the network.
+
+	// Deprecated: Use PrimaryNetworkStakingParams.MinStakeDuration.
+	MinStakeDuration = 24 * 14 * time.Hour // 2 weeks
+	// Deprecated: Use PrimaryNetworkStakingParams.MaxStakeDuration.
+	MaxStakeDuration = 24 * 365 * time.Hour // 1 year
+	// Deprecated: Use PrimaryNetworkStakingParams.MinStakeWeight.
+	MinStakeWeight = uint64(1)
+	// Deprecated: Use PrimaryNetworkStakingParams.MinStartLeadTime.
 	StakingStartLeadTime = 1 * time.Minute
-	TimeParseLayout      = "2006-01-02 15:04:05"
+
+	TimeParseLayout = "2006-01-02 15:04:05"
 
 	// Version management
 	LuxCompatibilityURL = "https://raw.githubusercontent.com/luxfi/cli/main/lux-compatibility.json"
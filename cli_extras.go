@@ -51,9 +51,10 @@ const (
 	LuxdCompatibilityURL   = LuxCompatibilityURL
 
 	// Default values for relayer and validators
-	DefaultRelayerAmount  = float64(10)
-	PayTxsFeesMsg         = "pay transaction fees"
-	LatestEVMVersion      = "v0.8.13"
+	DefaultRelayerAmount = float64(10)
+	PayTxsFeesMsg        = "pay transaction fees"
+	LatestEVMVersion     = "v0.8.13"
+	// Deprecated: Use PrimaryNetworkStakingParams.DefaultStakeWeight.
 	DefaultStakeWeight    = 20
 	DefaultConfigFileName = ".lux"
 	DefaultConfigFileType = "json"
@@ -107,9 +108,12 @@ const (
 
 	// Staking constants
 	BootstrapValidatorBalanceNanoLUX = 1_000_000_000_000 // 1000 LUX
-	BootstrapValidatorWeight         = 20                // Default validator weight
-	PoSL1MinimumStakeDurationSeconds = 86400             // 24 hours
-	StakingMinimumLeadTime           = 25 * time.Second
+	// Deprecated: Use DevStakingParams.DefaultStakeWeight.
+	BootstrapValidatorWeight = 20 // Default validator weight
+	// Deprecated: Use L1PoSStakingParams.MinStakeDuration.
+	PoSL1MinimumStakeDurationSeconds = 86400 // 24 hours
+	// Deprecated: Use DevStakingParams.MinStartLeadTime.
+	StakingMinimumLeadTime = 25 * time.Second
 
 	// Logging
 	DefaultAggregatorLogLevel = "INFO"
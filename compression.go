@@ -5,6 +5,8 @@ package constants
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -15,6 +17,12 @@ type CompressionType byte
 const (
 	CompressionTypeNone CompressionType = iota + 1
 	CompressionTypeZstd
+
+	// Reserved for Compressor implementations registered with
+	// RegisterCompressor, so every peer agrees on their wire values.
+	CompressionTypeGzip
+	CompressionTypeLZ4
+	CompressionTypeSnappy
 )
 
 func (t CompressionType) String() string {
@@ -23,6 +31,12 @@ func (t CompressionType) String() string {
 		return "none"
 	case CompressionTypeZstd:
 		return "zstd"
+	case CompressionTypeGzip:
+		return "gzip"
+	case CompressionTypeLZ4:
+		return "lz4"
+	case CompressionTypeSnappy:
+		return "snappy"
 	default:
 		return "unknown"
 	}
@@ -34,6 +48,12 @@ func CompressionTypeFromString(s string) (CompressionType, error) {
 		return CompressionTypeNone, nil
 	case CompressionTypeZstd.String():
 		return CompressionTypeZstd, nil
+	case CompressionTypeGzip.String():
+		return CompressionTypeGzip, nil
+	case CompressionTypeLZ4.String():
+		return CompressionTypeLZ4, nil
+	case CompressionTypeSnappy.String():
+		return CompressionTypeSnappy, nil
 	default:
 		return CompressionTypeNone, errUnknownCompressionType
 	}
@@ -52,3 +72,17 @@ func (t CompressionType) MarshalJSON() ([]byte, error) {
 	}
 	return []byte(b.String()), nil
 }
+
+// UnmarshalJSON parses the JSON string written by MarshalJSON.
+func (t *CompressionType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return fmt.Errorf("%w: %s", errUnknownCompressionType, b)
+	}
+	parsed, err := CompressionTypeFromString(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// MaxDictionarySize is the largest dictionary TrainDictionary builds.
+	// zstd's own trainer defaults to 110 KiB; P2P messages rarely benefit
+	// from more history than this.
+	MaxDictionarySize = 112 * KiB
+
+	// minDictionaryHistory is the smallest history zstd accepts.
+	minDictionaryHistory = 8
+)
+
+var (
+	ErrInvalidDictionary           = errors.New("invalid dictionary")
+	ErrDictionaryAlreadyRegistered = errors.New("dictionary already registered")
+	ErrUnknownDictionary           = errors.New("unknown dictionary")
+)
+
+// DictionaryID identifies a zstd dictionary. It is the ID stored in the
+// dictionary header and in every frame compressed with it, so a receiver can
+// look the dictionary up from the frame alone. Zero means "no dictionary".
+type DictionaryID uint32
+
+// DictionaryRegistry holds zstd dictionaries by ID.
+type DictionaryRegistry struct {
+	mu    sync.RWMutex
+	dicts map[DictionaryID][]byte
+}
+
+// DefaultDictionaryRegistry is the registry used by CompressionSpec and the
+// package-level dictionary functions.
+var DefaultDictionaryRegistry = NewDictionaryRegistry()
+
+// NewDictionaryRegistry creates a new, empty dictionary registry.
+func NewDictionaryRegistry() *DictionaryRegistry {
+	return &DictionaryRegistry{
+		dicts: make(map[DictionaryID][]byte),
+	}
+}
+
+// RegisterDictionary makes the serialized zstd dictionary [dict] available
+// under the ID in its header and returns that ID.
+func (r *DictionaryRegistry) RegisterDictionary(dict []byte) (DictionaryID, error) {
+	d, err := zstd.InspectDictionary(dict)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidDictionary, err)
+	}
+	id := DictionaryID(d.ID())
+	if id == 0 {
+		return 0, fmt.Errorf("%w: dictionary ID must be non-zero", ErrInvalidDictionary)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.dicts[id]; ok {
+		return 0, fmt.Errorf("%w: %d", ErrDictionaryAlreadyRegistered, id)
+	}
+	r.dicts[id] = dict
+	return id, nil
+}
+
+// Dictionary returns the dictionary registered under [id].
+func (r *DictionaryRegistry) Dictionary(id DictionaryID) ([]byte, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dict, ok := r.dicts[id]
+	return dict, ok
+}
+
+// NewZstdCompressor is NewZstdCompressorWithLevel using the dictionary
+// registered under [id].
+func (r *DictionaryRegistry) NewZstdCompressor(maxSize int64, level int, id DictionaryID) (Compressor, error) {
+	dict, ok := r.Dictionary(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownDictionary, id)
+	}
+	return newZstdCompressor(
+		maxSize,
+		level,
+		[]zstd.EOption{zstd.WithEncoderDict(dict)},
+		[]zstd.DOption{zstd.WithDecoderDicts(dict)},
+	)
+}
+
+// TrainDictionary builds a zstd dictionary with [id] from [samples], which
+// should be representative messages of the kind it will compress. The later
+// half of the samples, up to MaxDictionarySize bytes, becomes the dictionary
+// history; the earlier half is compressed against it to build the entropy
+// tables. The result still has to be registered with a DictionaryRegistry.
+func TrainDictionary(id DictionaryID, samples [][]byte) (dict []byte, err error) {
+	if id == 0 {
+		return nil, fmt.Errorf("%w: dictionary ID must be non-zero", ErrInvalidDictionary)
+	}
+
+	// Later samples end up closest to the data being compressed, where zstd
+	// finds matches most cheaply.
+	start, size := len(samples), 0
+	for start > len(samples)/2 && size+len(samples[start-1]) <= MaxDictionarySize {
+		start--
+		size += len(samples[start])
+	}
+	history := bytes.Join(samples[start:], nil)
+	contents := samples[:start]
+	if len(contents) == 0 {
+		return nil, fmt.Errorf("%w: need at least 2 samples, got %d", ErrInvalidDictionary, len(samples))
+	}
+	if len(history) < minDictionaryHistory {
+		return nil, fmt.Errorf("%w: %d bytes of samples < %d", ErrInvalidDictionary, len(history), minDictionaryHistory)
+	}
+
+	// BuildDict panics when the history matches the contents so well that no
+	// literals are left to build tables from.
+	defer func() {
+		if r := recover(); r != nil {
+			dict, err = nil, fmt.Errorf("%w: samples are too uniform: %v", ErrInvalidDictionary, r)
+		}
+	}()
+	dict, err = zstd.BuildDict(zstd.BuildDictOptions{
+		ID:       uint32(id),
+		Contents: contents,
+		History:  history,
+		Offsets:  [3]int{1, 4, 8},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDictionary, err)
+	}
+	return dict, nil
+}
+
+// Package-level convenience functions using DefaultDictionaryRegistry
+
+// RegisterDictionary registers [dict] with the DefaultDictionaryRegistry.
+func RegisterDictionary(dict []byte) (DictionaryID, error) {
+	return DefaultDictionaryRegistry.RegisterDictionary(dict)
+}
+
+// Dictionary returns the dictionary registered under [id] in the
+// DefaultDictionaryRegistry.
+func Dictionary(id DictionaryID) ([]byte, bool) {
+	return DefaultDictionaryRegistry.Dictionary(id)
+}
+
+// NewZstdDictCompressor is NewZstdCompressorWithLevel using the dictionary
+// registered under [id] in the DefaultDictionaryRegistry.
+func NewZstdDictCompressor(maxSize int64, level int, id DictionaryID) (Compressor, error) {
+	return DefaultDictionaryRegistry.NewZstdCompressor(maxSize, level, id)
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDictionary(t *testing.T) {
+	require := require.New(t)
+
+	const id DictionaryID = 0x4c5558
+	samples := make([][]byte, 64)
+	for i := range samples {
+		samples[i] = append(gossipLikeMsg(256+i), randomMsg(32)...)
+	}
+
+	_, err := TrainDictionary(0, samples)
+	require.ErrorIs(err, ErrInvalidDictionary)
+	_, err = TrainDictionary(id, nil)
+	require.ErrorIs(err, ErrInvalidDictionary)
+	_, err = TrainDictionary(id, [][]byte{gossipLikeMsg(256)})
+	require.ErrorIs(err, ErrInvalidDictionary)
+
+	dict, err := TrainDictionary(id, samples)
+	require.NoError(err)
+
+	r := NewDictionaryRegistry()
+	_, err = r.NewZstdCompressor(DefaultMaxMessageSize, 0, id)
+	require.ErrorIs(err, ErrUnknownDictionary)
+
+	registeredID, err := r.RegisterDictionary(dict)
+	require.NoError(err)
+	require.Equal(id, registeredID)
+	_, err = r.RegisterDictionary(dict)
+	require.ErrorIs(err, ErrDictionaryAlreadyRegistered)
+	_, err = r.RegisterDictionary([]byte("not a dictionary"))
+	require.ErrorIs(err, ErrInvalidDictionary)
+
+	got, ok := r.Dictionary(registeredID)
+	require.True(ok)
+	require.Equal(dict, got)
+
+	_, ok = NewDictionaryRegistry().Dictionary(registeredID)
+	require.False(ok)
+
+	withDict, err := r.NewZstdCompressor(DefaultMaxMessageSize, 0, registeredID)
+	require.NoError(err)
+	withoutDict, err := NewZstdCompressor(DefaultMaxMessageSize)
+	require.NoError(err)
+
+	msg := append(gossipLikeMsg(300), randomMsg(32)...)
+	compressed, err := withDict.Compress(msg)
+	require.NoError(err)
+	plain, err := withoutDict.Compress(msg)
+	require.NoError(err)
+	require.Less(len(compressed), len(plain))
+
+	decompressed, err := withDict.Decompress(compressed)
+	require.NoError(err)
+	require.Equal(msg, decompressed)
+
+	_, err = withoutDict.Decompress(compressed)
+	require.Error(err)
+}
+
+func TestCompressionSpecDictionary(t *testing.T) {
+	require := require.New(t)
+
+	// CompressionSpec resolves dictionaries in the default registry; give
+	// it a fresh one for the duration of the test.
+	defaultRegistry := DefaultDictionaryRegistry
+	DefaultDictionaryRegistry = NewDictionaryRegistry()
+	t.Cleanup(func() {
+		DefaultDictionaryRegistry = defaultRegistry
+	})
+
+	samples := make([][]byte, 16)
+	for i := range samples {
+		samples[i] = append(gossipLikeMsg(256+i), randomMsg(32)...)
+	}
+	dict, err := TrainDictionary(7, samples)
+	require.NoError(err)
+
+	spec := CompressionSpec{Algorithm: CompressionTypeZstd, Level: 3}
+	spec.Dictionary, err = RegisterDictionary(dict)
+	require.NoError(err)
+
+	c, err := spec.NewCompressor(DefaultMaxMessageSize)
+	require.NoError(err)
+	msg := samples[0]
+	compressed, err := c.Compress(msg)
+	require.NoError(err)
+	decompressed, err := c.Decompress(compressed)
+	require.NoError(err)
+	require.Equal(msg, decompressed)
+}
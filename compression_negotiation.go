@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"slices"
+	"strings"
+)
+
+// compressionPreference is the order in which Negotiate picks a mutually
+// supported algorithm, most preferred first. Both peers use the same order,
+// so they always agree on the result without another round trip. It is
+// part of the protocol and must not change at runtime.
+var compressionPreference = []CompressionType{
+	CompressionTypeZstd,
+	CompressionTypeLZ4,
+	CompressionTypeSnappy,
+	CompressionTypeGzip,
+	CompressionTypeNone,
+}
+
+// CompressionSet is a set of CompressionTypes, encoded as a bitmask with bit
+// i set when CompressionType(i) is supported. Peers advertise it in their
+// handshake; bits for types a peer doesn't know are ignored.
+type CompressionSet uint64
+
+// CompressionPreference returns the order in which Negotiate picks a
+// mutually supported algorithm, most preferred first.
+func CompressionPreference() []CompressionType {
+	return slices.Clone(compressionPreference)
+}
+
+// NewCompressionSet returns the set containing [types].
+func NewCompressionSet(types ...CompressionType) CompressionSet {
+	var s CompressionSet
+	for _, t := range types {
+		s = s.Add(t)
+	}
+	return s
+}
+
+// SupportedCompressionSet returns the set of types with a registered
+// Compressor, i.e. the set this node can advertise.
+func SupportedCompressionSet() CompressionSet {
+	compressorsLock.RLock()
+	defer compressorsLock.RUnlock()
+
+	var s CompressionSet
+	for t := range compressors {
+		s = s.Add(t)
+	}
+	return s
+}
+
+// Add returns [s] with [t] added. Types that don't fit in the bitmask are
+// ignored.
+func (s CompressionSet) Add(t CompressionType) CompressionSet {
+	if t >= 64 {
+		return s
+	}
+	return s | 1<<t
+}
+
+// Contains reports whether [t] is in [s].
+func (s CompressionSet) Contains(t CompressionType) bool {
+	return t < 64 && s&(1<<t) != 0
+}
+
+// Types returns the members of [s] in ascending wire order.
+func (s CompressionSet) Types() []CompressionType {
+	var types []CompressionType
+	for t := CompressionType(0); t < 64; t++ {
+		if s.Contains(t) {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+func (s CompressionSet) String() string {
+	types := s.Types()
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return strings.Join(names, ",")
+}
+
+// Negotiate returns the most preferred CompressionType, according to
+// CompressionPreference, that both [local] and [remote] support. It falls
+// back to CompressionTypeNone when they share nothing, which every peer can
+// always decode. The result doesn't depend on which side calls it.
+func Negotiate(local, remote CompressionSet) CompressionType {
+	mutual := local & remote
+	for _, t := range compressionPreference {
+		if mutual.Contains(t) {
+			return t
+		}
+	}
+	return CompressionTypeNone
+}
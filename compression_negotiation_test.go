@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name     string
+		local    CompressionSet
+		remote   CompressionSet
+		expected CompressionType
+	}{
+		{
+			name:     "same sets",
+			local:    NewCompressionSet(CompressionTypeNone, CompressionTypeZstd),
+			remote:   NewCompressionSet(CompressionTypeNone, CompressionTypeZstd),
+			expected: CompressionTypeZstd,
+		},
+		{
+			name:     "old peer",
+			local:    NewCompressionSet(CompressionTypeNone, CompressionTypeZstd, CompressionTypeLZ4),
+			remote:   NewCompressionSet(CompressionTypeNone, CompressionTypeZstd),
+			expected: CompressionTypeZstd,
+		},
+		{
+			name:     "preference beats local order",
+			local:    NewCompressionSet(CompressionTypeGzip, CompressionTypeSnappy),
+			remote:   NewCompressionSet(CompressionTypeSnappy, CompressionTypeGzip),
+			expected: CompressionTypeSnappy,
+		},
+		{
+			name:     "nothing in common",
+			local:    NewCompressionSet(CompressionTypeZstd),
+			remote:   NewCompressionSet(CompressionTypeGzip),
+			expected: CompressionTypeNone,
+		},
+		{
+			name:     "unknown remote bits",
+			local:    NewCompressionSet(CompressionTypeZstd),
+			remote:   NewCompressionSet(CompressionTypeZstd) | 1<<63,
+			expected: CompressionTypeZstd,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			require.Equal(test.expected, Negotiate(test.local, test.remote))
+			require.Equal(test.expected, Negotiate(test.remote, test.local))
+		})
+	}
+}
+
+func TestSupportedCompressionSet(t *testing.T) {
+	require := require.New(t)
+
+	s := SupportedCompressionSet()
+	require.True(s.Contains(CompressionTypeNone))
+	require.True(s.Contains(DefaultNetworkCompressionType))
+	require.Equal("none,zstd", NewCompressionSet(CompressionTypeZstd, CompressionTypeNone).String())
+}
+
+func TestCompressionPreferenceIsACopy(t *testing.T) {
+	require := require.New(t)
+
+	preference := CompressionPreference()
+	require.Equal(CompressionTypeZstd, preference[0])
+	require.Equal(CompressionTypeNone, preference[len(preference)-1])
+
+	preference[0] = CompressionTypeGzip
+	require.Equal(CompressionTypeZstd, CompressionPreference()[0])
+	require.Equal(CompressionTypeZstd, Negotiate(
+		NewCompressionSet(CompressionTypeZstd, CompressionTypeGzip),
+		NewCompressionSet(CompressionTypeZstd, CompressionTypeGzip),
+	))
+}
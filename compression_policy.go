@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Defaults for CompressionPolicy, justified by the benchmarks in
+// compression_policy_test.go.
+const (
+	// DefaultCompressionMinSize is the smallest message worth compressing.
+	// zstd has a fixed cost of ~6µs per call up to a few hundred bytes, so
+	// below this size it saves at most a couple hundred bytes for the
+	// same latency as compressing a full 1 KiB message.
+	DefaultCompressionMinSize = 256
+
+	// DefaultCompressionSampleSize is how many leading bytes are sampled to
+	// estimate entropy. A sample costs a few microseconds, less than a
+	// wasted zstd call on any message that reaches the sampler.
+	DefaultCompressionSampleSize = 512
+
+	// DefaultCompressionMaxEntropy is the sample entropy, in bits per byte,
+	// above which a message is treated as already compressed or encrypted.
+	// Random data samples at ~7.6 bits/byte with a 512-byte sample; typical
+	// protocol messages stay below 6.
+	DefaultCompressionMaxEntropy = 7.2
+)
+
+var errMissingCompressionHeader = errors.New("missing compression header")
+
+// CompressionSkipReason explains why an AdaptiveCompressor sent a message
+// uncompressed.
+type CompressionSkipReason byte
+
+const (
+	CompressionNotSkipped CompressionSkipReason = iota
+	CompressionSkippedTooSmall
+	CompressionSkippedIncompressible
+	CompressionSkippedNoGain
+)
+
+func (r CompressionSkipReason) String() string {
+	switch r {
+	case CompressionNotSkipped:
+		return "not skipped"
+	case CompressionSkippedTooSmall:
+		return "too small"
+	case CompressionSkippedIncompressible:
+		return "incompressible"
+	case CompressionSkippedNoGain:
+		return "no gain"
+	default:
+		return "unknown"
+	}
+}
+
+// CompressionStats describes a single AdaptiveCompressor.Compress call.
+type CompressionStats struct {
+	// Algorithm is the algorithm the message was sent with.
+	Algorithm  CompressionType
+	Skipped    CompressionSkipReason
+	InputSize  int
+	OutputSize int // including the 1-byte header
+	// SampleEntropy is the estimated entropy in bits per byte, or 0 if the
+	// message wasn't sampled.
+	SampleEntropy float64
+	Duration      time.Duration
+}
+
+// CompressionPolicy decides which messages are worth compressing.
+type CompressionPolicy struct {
+	// MinSize is the smallest message that is compressed.
+	MinSize int
+	// SampleSize is the number of leading bytes used to estimate entropy.
+	// Zero disables the incompressibility check.
+	SampleSize int
+	// MaxEntropy is the sample entropy, in bits per byte, above which a
+	// message is sent uncompressed.
+	MaxEntropy float64
+}
+
+// DefaultCompressionPolicy is the policy built from the package defaults.
+var DefaultCompressionPolicy = CompressionPolicy{
+	MinSize:    DefaultCompressionMinSize,
+	SampleSize: DefaultCompressionSampleSize,
+	MaxEntropy: DefaultCompressionMaxEntropy,
+}
+
+// AdaptiveCompressor wraps a Compressor and applies a CompressionPolicy.
+// Every output starts with a 1-byte CompressionType header naming the
+// algorithm actually used, so a skipped message is never bigger than its
+// input plus one byte and the receiver needs no extra signalling.
+type AdaptiveCompressor struct {
+	policy    CompressionPolicy
+	algorithm CompressionType
+	inner     Compressor
+	none      Compressor
+}
+
+// NewAdaptiveCompressor wraps [inner], which compresses with [algorithm],
+// and limits messages to [maxSize] bytes.
+func NewAdaptiveCompressor(policy CompressionPolicy, algorithm CompressionType, inner Compressor, maxSize int64) *AdaptiveCompressor {
+	return &AdaptiveCompressor{
+		policy:    policy,
+		algorithm: algorithm,
+		inner:     inner,
+		none:      NewNoCompressor(maxSize),
+	}
+}
+
+// Compress implements Compressor.
+func (c *AdaptiveCompressor) Compress(msg []byte) ([]byte, error) {
+	compressed, _, err := c.CompressWithStats(msg)
+	return compressed, err
+}
+
+// CompressWithStats compresses [msg] and reports what the policy decided.
+func (c *AdaptiveCompressor) CompressWithStats(msg []byte) ([]byte, CompressionStats, error) {
+	start := time.Now()
+	stats := CompressionStats{
+		Algorithm: c.algorithm,
+		InputSize: len(msg),
+	}
+
+	switch {
+	case len(msg) < c.policy.MinSize:
+		stats.Skipped = CompressionSkippedTooSmall
+	case c.policy.SampleSize > 0:
+		stats.SampleEntropy = SampleEntropy(msg, c.policy.SampleSize)
+		if stats.SampleEntropy > c.policy.MaxEntropy {
+			stats.Skipped = CompressionSkippedIncompressible
+		}
+	}
+
+	var out []byte
+	if stats.Skipped == CompressionNotSkipped {
+		compressed, err := c.inner.Compress(msg)
+		if err != nil {
+			return nil, stats, err
+		}
+		if len(compressed) < len(msg) {
+			out = append([]byte{byte(c.algorithm)}, compressed...)
+		} else {
+			stats.Skipped = CompressionSkippedNoGain
+		}
+	}
+	if stats.Skipped != CompressionNotSkipped {
+		raw, err := c.none.Compress(msg)
+		if err != nil {
+			return nil, stats, err
+		}
+		stats.Algorithm = CompressionTypeNone
+		out = append([]byte{byte(CompressionTypeNone)}, raw...)
+	}
+
+	stats.OutputSize = len(out)
+	stats.Duration = time.Since(start)
+	return out, stats, nil
+}
+
+// Decompress implements Compressor.
+func (c *AdaptiveCompressor) Decompress(msg []byte) ([]byte, error) {
+	if len(msg) == 0 {
+		return nil, errMissingCompressionHeader
+	}
+	switch t := CompressionType(msg[0]); t {
+	case CompressionTypeNone:
+		return c.none.Decompress(msg[1:])
+	case c.algorithm:
+		return c.inner.Decompress(msg[1:])
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownCompressionType, t)
+	}
+}
+
+// SampleEntropy estimates the Shannon entropy, in bits per byte, of the
+// first [sampleSize] bytes of [msg].
+func SampleEntropy(msg []byte, sampleSize int) float64 {
+	sample := msg[:min(len(msg), sampleSize)]
+	if len(sample) == 0 {
+		return 0
+	}
+
+	var counts [256]int
+	for _, b := range sample {
+		counts[b]++
+	}
+	n := float64(len(sample))
+	entropy := 0.0
+	for _, count := range counts {
+		if count == 0 {
+			continue
+		}
+		p := float64(count) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
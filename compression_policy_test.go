@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// gossipLikeMsg returns [size] bytes shaped like a protocol message: short
+// repeated field tags around mostly-zero IDs and small integers.
+func gossipLikeMsg(size int) []byte {
+	var b bytes.Buffer
+	for i := 0; b.Len() < size; i++ {
+		b.WriteString("\x0a\x20")
+		b.Write(make([]byte, 28))
+		b.WriteByte(byte('A' + i%26))
+		b.WriteString("\x10")
+		b.WriteByte(byte(i))
+	}
+	return b.Bytes()[:size]
+}
+
+func randomMsg(size int) []byte {
+	b := make([]byte, size)
+	_, _ = rand.Read(b)
+	return b
+}
+
+func newTestAdaptiveCompressor(t testing.TB) *AdaptiveCompressor {
+	zstd, err := NewZstdCompressor(DefaultMaxMessageSize)
+	require.NoError(t, err)
+	return NewAdaptiveCompressor(DefaultCompressionPolicy, CompressionTypeZstd, zstd, DefaultMaxMessageSize)
+}
+
+func TestAdaptiveCompressor(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       []byte
+		algorithm CompressionType
+		skipped   CompressionSkipReason
+	}{
+		{
+			name:      "small",
+			msg:       gossipLikeMsg(DefaultCompressionMinSize - 1),
+			algorithm: CompressionTypeNone,
+			skipped:   CompressionSkippedTooSmall,
+		},
+		{
+			name:      "compressible",
+			msg:       gossipLikeMsg(4 * KiB),
+			algorithm: CompressionTypeZstd,
+			skipped:   CompressionNotSkipped,
+		},
+		{
+			name:      "random",
+			msg:       randomMsg(4 * KiB),
+			algorithm: CompressionTypeNone,
+			skipped:   CompressionSkippedIncompressible,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			c := newTestAdaptiveCompressor(t)
+			out, stats, err := c.CompressWithStats(test.msg)
+			require.NoError(err)
+			require.Equal(test.algorithm, stats.Algorithm)
+			require.Equal(test.skipped, stats.Skipped)
+			require.Equal(len(test.msg), stats.InputSize)
+			require.Equal(len(out), stats.OutputSize)
+			require.LessOrEqual(len(out), len(test.msg)+1)
+
+			decompressed, err := c.Decompress(out)
+			require.NoError(err)
+			require.Equal(test.msg, decompressed)
+		})
+	}
+}
+
+func TestAdaptiveCompressorNoGain(t *testing.T) {
+	require := require.New(t)
+
+	zstd, err := NewZstdCompressor(DefaultMaxMessageSize)
+	require.NoError(err)
+	policy := CompressionPolicy{MinSize: 0} // no sampling
+	c := NewAdaptiveCompressor(policy, CompressionTypeZstd, zstd, DefaultMaxMessageSize)
+
+	msg := randomMsg(KiB)
+	out, stats, err := c.CompressWithStats(msg)
+	require.NoError(err)
+	require.Equal(CompressionSkippedNoGain, stats.Skipped)
+	require.Len(out, len(msg)+1)
+
+	_, err = c.Decompress(nil)
+	require.ErrorIs(err, errMissingCompressionHeader)
+}
+
+var benchmarkSizes = []int{64, 128, 256, 512, 4 * KiB, 64 * KiB}
+
+// BenchmarkZstdCompress shows the fixed per-call cost of zstd and the bytes
+// it saves on protocol-like messages, which motivates
+// DefaultCompressionMinSize.
+func BenchmarkZstdCompress(b *testing.B) {
+	zstd, err := NewZstdCompressor(DefaultMaxMessageSize)
+	require.NoError(b, err)
+	for _, size := range benchmarkSizes {
+		msg := gossipLikeMsg(size)
+		b.Run(fmt.Sprintf("gossip/%d", size), func(b *testing.B) {
+			var saved int
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				out, err := zstd.Compress(msg)
+				require.NoError(b, err)
+				saved = size - len(out)
+			}
+			b.ReportMetric(float64(saved), "saved-bytes")
+		})
+	}
+}
+
+// BenchmarkZstdCompressRandom shows the cost of compressing data that can't
+// shrink, which the entropy sample avoids.
+func BenchmarkZstdCompressRandom(b *testing.B) {
+	zstd, err := NewZstdCompressor(DefaultMaxMessageSize)
+	require.NoError(b, err)
+	for _, size := range benchmarkSizes {
+		msg := randomMsg(size)
+		b.Run(fmt.Sprint(size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				_, err := zstd.Compress(msg)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// BenchmarkSampleEntropy shows the cost of the incompressibility check and
+// the entropy it reports for DefaultCompressionSampleSize, which motivates
+// DefaultCompressionMaxEntropy.
+func BenchmarkSampleEntropy(b *testing.B) {
+	inputs := map[string][]byte{
+		"gossip": gossipLikeMsg(64 * KiB),
+		"random": randomMsg(64 * KiB),
+	}
+	for name, msg := range inputs {
+		b.Run(name, func(b *testing.B) {
+			var entropy float64
+			for i := 0; i < b.N; i++ {
+				entropy = SampleEntropy(msg, DefaultCompressionSampleSize)
+			}
+			b.ReportMetric(entropy, "bits/byte")
+		})
+	}
+}
+
+// BenchmarkAdaptiveCompress compares the policy against always compressing.
+func BenchmarkAdaptiveCompress(b *testing.B) {
+	c := newTestAdaptiveCompressor(b)
+	inputs := map[string][]byte{
+		"gossip/128":  gossipLikeMsg(128),
+		"gossip/4096": gossipLikeMsg(4 * KiB),
+		"random/4096": randomMsg(4 * KiB),
+	}
+	for name, msg := range inputs {
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(msg)))
+			for i := 0; i < b.N; i++ {
+				_, err := c.Compress(msg)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	compressionSpecSeparator  = ":"
+	compressionSpecDictPrefix = "@"
+	compressionSpecFlagType   = "compression"
+)
+
+var (
+	ErrInvalidCompressionLevel = errors.New("invalid compression level")
+
+	// compressionLevelRanges are the valid levels of each algorithm that
+	// has a leveled Compressor. Level 0 always means "library default".
+	compressionLevelRanges = map[CompressionType]struct{ min, max int }{
+		CompressionTypeZstd: {1, 22},
+	}
+
+	_ encoding.TextMarshaler   = CompressionSpec{}
+	_ encoding.TextUnmarshaler = (*CompressionSpec)(nil)
+	_ json.Marshaler           = CompressionSpec{}
+	_ json.Unmarshaler         = (*CompressionSpec)(nil)
+	_ flag.Value               = (*CompressionSpec)(nil)
+)
+
+// CompressionSpec is a CompressionType with an optional level and zstd
+// dictionary, written as "zstd", "zstd:3", "zstd:3@42" or "none". The level
+// only affects the compressing side; send Algorithm and Dictionary on the
+// wire.
+//
+// CompressionSpec implements encoding.TextMarshaler, so YAML libraries
+// round-trip it too.
+type CompressionSpec struct {
+	Algorithm CompressionType
+	Level     int // 0 selects the algorithm's default level
+	// Dictionary is the registered zstd dictionary to use, or 0 for none.
+	Dictionary DictionaryID
+}
+
+// DefaultNetworkCompressionSpec is DefaultNetworkCompressionType at its
+// default level.
+var DefaultNetworkCompressionSpec = CompressionSpec{Algorithm: DefaultNetworkCompressionType}
+
+// ParseCompressionSpec parses "<type>[:<level>][@<dictionary ID>]".
+func ParseCompressionSpec(s string) (CompressionSpec, error) {
+	rest, dictStr, hasDict := strings.Cut(strings.ToLower(strings.TrimSpace(s)), compressionSpecDictPrefix)
+	name, levelStr, hasLevel := strings.Cut(rest, compressionSpecSeparator)
+	t, err := CompressionTypeFromString(name)
+	if err != nil {
+		return CompressionSpec{}, fmt.Errorf("%w: %q", err, s)
+	}
+
+	spec := CompressionSpec{Algorithm: t}
+	if hasDict {
+		id, err := strconv.ParseUint(dictStr, 10, 32)
+		if err != nil || id == 0 {
+			return CompressionSpec{}, fmt.Errorf("%w: %q", ErrInvalidDictionary, s)
+		}
+		spec.Dictionary = DictionaryID(id)
+	}
+	if hasLevel {
+		spec.Level, err = strconv.Atoi(levelStr)
+		if err != nil {
+			return CompressionSpec{}, fmt.Errorf("%w: %q", ErrInvalidCompressionLevel, s)
+		}
+	}
+	if err := spec.Verify(); err != nil {
+		return CompressionSpec{}, err
+	}
+	return spec, nil
+}
+
+// Verify checks that Algorithm is known and that Level and Dictionary are
+// valid for it. It doesn't check that Dictionary is registered. The zero
+// CompressionSpec fails.
+func (s CompressionSpec) Verify() error {
+	if _, err := CompressionTypeFromString(s.Algorithm.String()); err != nil {
+		return fmt.Errorf("%w: %d", err, s.Algorithm)
+	}
+	if s.Dictionary != 0 && s.Algorithm != CompressionTypeZstd {
+		return fmt.Errorf("%w: %s doesn't take a dictionary", ErrInvalidDictionary, s.Algorithm)
+	}
+	if s.Level == 0 {
+		return nil
+	}
+	r, ok := compressionLevelRanges[s.Algorithm]
+	if !ok {
+		return fmt.Errorf("%w: %s doesn't take a level", ErrInvalidCompressionLevel, s.Algorithm)
+	}
+	if s.Level < r.min || s.Level > r.max {
+		return fmt.Errorf("%w: %s level %d not in [%d, %d]", ErrInvalidCompressionLevel, s.Algorithm, s.Level, r.min, r.max)
+	}
+	return nil
+}
+
+func (s CompressionSpec) String() string {
+	str := s.Algorithm.String()
+	if s.Level != 0 {
+		str += compressionSpecSeparator + strconv.Itoa(s.Level)
+	}
+	if s.Dictionary != 0 {
+		str += compressionSpecDictPrefix + strconv.FormatUint(uint64(s.Dictionary), 10)
+	}
+	return str
+}
+
+// NewCompressor returns a Compressor for [s] limited to [maxSize] bytes.
+// Levels and dictionaries are only supported for zstd; other types use the
+// Compressor registered with RegisterCompressor.
+func (s CompressionSpec) NewCompressor(maxSize int64) (Compressor, error) {
+	if err := s.Verify(); err != nil {
+		return nil, err
+	}
+	switch {
+	case s.Dictionary != 0:
+		return NewZstdDictCompressor(maxSize, s.Level, s.Dictionary)
+	case s.Level != 0:
+		return NewZstdCompressorWithLevel(maxSize, s.Level)
+	default:
+		return NewCompressor(s.Algorithm, maxSize)
+	}
+}
+
+// MarshalText writes the spec as String does. It fails for specs that don't
+// Verify, such as the zero CompressionSpec, which wouldn't parse back.
+func (s CompressionSpec) MarshalText() ([]byte, error) {
+	if err := s.Verify(); err != nil {
+		return nil, err
+	}
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText parses the spec as ParseCompressionSpec does.
+func (s *CompressionSpec) UnmarshalText(text []byte) error {
+	parsed, err := ParseCompressionSpec(string(text))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// MarshalJSON writes the spec as a JSON string.
+func (s CompressionSpec) MarshalJSON() ([]byte, error) {
+	text, err := s.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strconv.Quote(string(text))), nil
+}
+
+// UnmarshalJSON parses a JSON string as ParseCompressionSpec does.
+func (s *CompressionSpec) UnmarshalJSON(b []byte) error {
+	var text string
+	if err := json.Unmarshal(b, &text); err != nil {
+		return err
+	}
+	return s.UnmarshalText([]byte(text))
+}
+
+// Set implements flag.Value and the spf13/pflag Value interface.
+func (s *CompressionSpec) Set(text string) error {
+	return s.UnmarshalText([]byte(text))
+}
+
+// Type implements the spf13/pflag Value interface.
+func (*CompressionSpec) Type() string {
+	return compressionSpecFlagType
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseCompressionSpec(t *testing.T) {
+	tests := []struct {
+		s           string
+		spec        CompressionSpec
+		expectedErr error
+	}{
+		{
+			s:    "zstd",
+			spec: CompressionSpec{Algorithm: CompressionTypeZstd},
+		},
+		{
+			s:    "ZSTD:3",
+			spec: CompressionSpec{Algorithm: CompressionTypeZstd, Level: 3},
+		},
+		{
+			s:    "none",
+			spec: CompressionSpec{Algorithm: CompressionTypeNone},
+		},
+		{
+			s:    "gzip",
+			spec: CompressionSpec{Algorithm: CompressionTypeGzip},
+		},
+		{
+			// No leveled gzip compressor exists, so the level would fail at
+			// startup instead of at parse time.
+			s:           "gzip:9",
+			expectedErr: ErrInvalidCompressionLevel,
+		},
+		{
+			s:           "lz4:12",
+			expectedErr: ErrInvalidCompressionLevel,
+		},
+		{
+			s:    "zstd:3@42",
+			spec: CompressionSpec{Algorithm: CompressionTypeZstd, Level: 3, Dictionary: 42},
+		},
+		{
+			s:    "zstd@42",
+			spec: CompressionSpec{Algorithm: CompressionTypeZstd, Dictionary: 42},
+		},
+		{
+			s:           "zstd@0",
+			expectedErr: ErrInvalidDictionary,
+		},
+		{
+			s:           "gzip@42",
+			expectedErr: ErrInvalidDictionary,
+		},
+		{
+			s:           "zstd:23",
+			expectedErr: ErrInvalidCompressionLevel,
+		},
+		{
+			s:           "none:1",
+			expectedErr: ErrInvalidCompressionLevel,
+		},
+		{
+			s:           "snappy:1",
+			expectedErr: ErrInvalidCompressionLevel,
+		},
+		{
+			s:           "zstd:fast",
+			expectedErr: ErrInvalidCompressionLevel,
+		},
+		{
+			s:           "brotli",
+			expectedErr: errUnknownCompressionType,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.s, func(t *testing.T) {
+			require := require.New(t)
+
+			spec, err := ParseCompressionSpec(test.s)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.spec, spec)
+		})
+	}
+}
+
+func TestCompressionSpecRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	type config struct {
+		Compression CompressionSpec `json:"compression" yaml:"compression"`
+	}
+	want := config{Compression: CompressionSpec{Algorithm: CompressionTypeZstd, Level: 3}}
+
+	b, err := json.Marshal(want)
+	require.NoError(err)
+	require.JSONEq(`{"compression":"zstd:3"}`, string(b))
+	var got config
+	require.NoError(json.Unmarshal(b, &got))
+	require.Equal(want, got)
+
+	b, err = yaml.Marshal(want)
+	require.NoError(err)
+	require.Equal("compression: zstd:3\n", string(b))
+	got = config{}
+	require.NoError(yaml.Unmarshal(b, &got))
+	require.Equal(want, got)
+
+	var spec CompressionSpec
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&spec, "network-compression-type", "compression algorithm")
+	require.NoError(fs.Parse([]string{"--network-compression-type=none"}))
+	require.Equal(CompressionTypeNone, spec.Algorithm)
+
+	var compressionType CompressionType
+	require.NoError(json.Unmarshal([]byte(`"zstd"`), &compressionType))
+	require.Equal(CompressionTypeZstd, compressionType)
+}
+
+func TestCompressionSpecZeroValue(t *testing.T) {
+	require := require.New(t)
+
+	var spec CompressionSpec
+	require.ErrorIs(spec.Verify(), errUnknownCompressionType)
+
+	_, err := spec.MarshalText()
+	require.ErrorIs(err, errUnknownCompressionType)
+
+	_, err = json.Marshal(struct {
+		Compression CompressionSpec `json:"compression"`
+	}{})
+	require.ErrorIs(err, errUnknownCompressionType)
+
+	_, err = spec.NewCompressor(1024)
+	require.ErrorIs(err, errUnknownCompressionType)
+}
+
+func TestCompressionSpecNewCompressor(t *testing.T) {
+	require := require.New(t)
+
+	c, err := CompressionSpec{Algorithm: CompressionTypeZstd, Level: 19}.NewCompressor(DefaultMaxMessageSize)
+	require.NoError(err)
+
+	msg := []byte("lux lux lux lux lux lux lux lux")
+	compressed, err := c.Compress(msg)
+	require.NoError(err)
+	decompressed, err := c.Decompress(compressed)
+	require.NoError(err)
+	require.Equal(msg, decompressed)
+}
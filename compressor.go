@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrMsgTooLarge                 = errors.New("msg too large to be compressed")
+	ErrDecompressedMsgTooLarge     = errors.New("decompressed msg too large")
+	ErrCompressorAlreadyRegistered = errors.New("compressor already registered")
+	ErrNoCompressor                = errors.New("no compressor registered")
+
+	compressorsLock sync.RWMutex
+	compressors     = map[CompressionType]CompressorFactory{
+		CompressionTypeNone: func(maxSize int64) (Compressor, error) {
+			return NewNoCompressor(maxSize), nil
+		},
+		CompressionTypeZstd: NewZstdCompressor,
+	}
+)
+
+// Compressor compresses and decompresses messages.
+type Compressor interface {
+	// Compress [msg] and return the compressed bytes.
+	Compress(msg []byte) ([]byte, error)
+	// Decompress [msg] and return the decompressed bytes. Implementations
+	// must refuse to produce more than their configured maximum size.
+	Decompress(msg []byte) ([]byte, error)
+}
+
+// CompressorFactory creates a Compressor that handles messages of at most
+// [maxSize] bytes, both before compression and after decompression.
+type CompressorFactory func(maxSize int64) (Compressor, error)
+
+// RegisterCompressor makes [factory] the implementation of [t]. None and
+// zstd are registered by default; reserved types such as
+// CompressionTypeGzip must be registered by the package providing them.
+func RegisterCompressor(t CompressionType, factory CompressorFactory) error {
+	compressorsLock.Lock()
+	defer compressorsLock.Unlock()
+
+	if _, ok := compressors[t]; ok {
+		return fmt.Errorf("%w: %s", ErrCompressorAlreadyRegistered, t)
+	}
+	compressors[t] = factory
+	return nil
+}
+
+// NewCompressor returns a Compressor of type [t] limited to [maxSize] bytes.
+func NewCompressor(t CompressionType, maxSize int64) (Compressor, error) {
+	compressorsLock.RLock()
+	factory, ok := compressors[t]
+	compressorsLock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoCompressor, t)
+	}
+	return factory(maxSize)
+}
+
+// NewDefaultCompressor returns a Compressor of type [t] limited to
+// DefaultMaxMessageSize.
+func NewDefaultCompressor(t CompressionType) (Compressor, error) {
+	return NewCompressor(t, DefaultMaxMessageSize)
+}
+
+// Compressor returns a Compressor of this type limited to
+// DefaultMaxMessageSize.
+func (t CompressionType) Compressor() (Compressor, error) {
+	return NewDefaultCompressor(t)
+}
+
+type noCompressor struct {
+	maxSize int64
+}
+
+// NewNoCompressor returns a Compressor that passes messages through
+// unchanged, still enforcing [maxSize].
+func NewNoCompressor(maxSize int64) Compressor {
+	return &noCompressor{maxSize: maxSize}
+}
+
+func (c *noCompressor) Compress(msg []byte) ([]byte, error) {
+	if int64(len(msg)) > c.maxSize {
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrMsgTooLarge, len(msg), c.maxSize)
+	}
+	return msg, nil
+}
+
+func (c *noCompressor) Decompress(msg []byte) ([]byte, error) {
+	if int64(len(msg)) > c.maxSize {
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrDecompressedMsgTooLarge, len(msg), c.maxSize)
+	}
+	return msg, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressorRoundTrip(t *testing.T) {
+	for _, compressionType := range []CompressionType{CompressionTypeNone, CompressionTypeZstd} {
+		t.Run(compressionType.String(), func(t *testing.T) {
+			require := require.New(t)
+
+			c, err := compressionType.Compressor()
+			require.NoError(err)
+
+			msg := bytes.Repeat([]byte("lux gossip "), 1024)
+			compressed, err := c.Compress(msg)
+			require.NoError(err)
+
+			decompressed, err := c.Decompress(compressed)
+			require.NoError(err)
+			require.Equal(msg, decompressed)
+
+			_, err = c.Compress(make([]byte, DefaultMaxMessageSize+1))
+			require.ErrorIs(err, ErrMsgTooLarge)
+		})
+	}
+}
+
+func TestZstdDecompressLimit(t *testing.T) {
+	require := require.New(t)
+
+	const maxSize = 1024
+	big, err := NewZstdCompressor(2 * maxSize)
+	require.NoError(err)
+	small, err := NewZstdCompressor(maxSize)
+	require.NoError(err)
+
+	// A tiny frame that expands beyond the receiver's limit.
+	bomb, err := big.Compress(make([]byte, maxSize+1))
+	require.NoError(err)
+	require.Less(len(bomb), maxSize)
+
+	_, err = small.Decompress(bomb)
+	require.ErrorIs(err, ErrDecompressedMsgTooLarge)
+}
+
+func TestRegisterCompressor(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewDefaultCompressor(CompressionTypeSnappy)
+	require.ErrorIs(err, ErrNoCompressor)
+
+	err = RegisterCompressor(CompressionTypeZstd, NewZstdCompressor)
+	require.ErrorIs(err, ErrCompressorAlreadyRegistered)
+}
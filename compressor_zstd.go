@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var errInvalidMaxSize = errors.New("max size must be positive")
+
+type zstdCompressor struct {
+	maxSize int64
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// NewZstdCompressor returns a zstd Compressor limited to [maxSize] bytes.
+// The decoder refuses to allocate more than [maxSize] bytes, so a small
+// malicious frame can't expand into an unbounded buffer.
+func NewZstdCompressor(maxSize int64) (Compressor, error) {
+	return NewZstdCompressorWithLevel(maxSize, 0)
+}
+
+// NewZstdCompressorWithLevel is NewZstdCompressor with a standard zstd
+// compression [level] (1-22). Level 0 selects the library default.
+func NewZstdCompressorWithLevel(maxSize int64, level int) (Compressor, error) {
+	return newZstdCompressor(maxSize, level, nil, nil)
+}
+
+func newZstdCompressor(maxSize int64, level int, eopts []zstd.EOption, dopts []zstd.DOption) (Compressor, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidMaxSize, maxSize)
+	}
+	if level != 0 {
+		eopts = append(eopts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	}
+	encoder, err := zstd.NewWriter(nil, eopts...)
+	if err != nil {
+		return nil, err
+	}
+	dopts = append(dopts, zstd.WithDecoderMaxMemory(uint64(maxSize)))
+	decoder, err := zstd.NewReader(nil, dopts...)
+	if err != nil {
+		return nil, err
+	}
+	return &zstdCompressor{
+		maxSize: maxSize,
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+func (c *zstdCompressor) Compress(msg []byte) ([]byte, error) {
+	if int64(len(msg)) > c.maxSize {
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrMsgTooLarge, len(msg), c.maxSize)
+	}
+	return c.encoder.EncodeAll(msg, nil), nil
+}
+
+func (c *zstdCompressor) Decompress(msg []byte) ([]byte, error) {
+	decompressed, err := c.decoder.DecodeAll(msg, nil)
+	switch {
+	case errors.Is(err, zstd.ErrDecoderSizeExceeded):
+		return nil, fmt.Errorf("%w: %w", ErrDecompressedMsgTooLarge, err)
+	case err != nil:
+		return nil, err
+	case int64(len(decompressed)) > c.maxSize:
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrDecompressedMsgTooLarge, len(decompressed), c.maxSize)
+	default:
+		return decompressed, nil
+	}
+}
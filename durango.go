@@ -0,0 +1,18 @@
+// Copyright (C) 2022-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import "time"
+
+// DurangoActivationTime provides network activation times for Durango.
+// Prefer DefaultUpgradeSchedule, which also covers custom networks.
+var DurangoActivationTime = map[NetworkID]time.Time{
+	// params.LuxMainnetChainConfig in luxfi/geth sets DurangoTimestamp to 0.
+	MainnetID: GenesisActivationTime,
+	// params.LuxTestnetChainConfig in luxfi/geth runs Shanghai, Durango's
+	// C-Chain change (ACP-24), from genesis.
+	TestnetID:      GenesisActivationTime,
+	DevnetID:       GenesisActivationTime, // Devnet activates at genesis
+	LocalNetworkID: GenesisActivationTime, // Local networks activate immediately (Unix epoch)
+}
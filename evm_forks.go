@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/luxfi/geth/params"
+)
+
+// EVMForks holds the C-Chain EVM fork times of one network as geth
+// timestamps. A nil time means the fork isn't scheduled; 0 means it is
+// active from genesis.
+type EVMForks struct {
+	// ChainID is the network's EIP-155 chain ID, or 0 if it has none, as on
+	// custom networks.
+	ChainID      EVMChainID
+	DurangoTime  *uint64
+	ShanghaiTime *uint64 // ACP-24
+	CancunTime   *uint64 // ACP-131
+}
+
+// luxChainConfigs are the C-Chain configs that the public networks run,
+// pinned in luxfi/geth.
+var luxChainConfigs = map[NetworkID]*params.ChainConfig{
+	MainnetID: params.LuxMainnetChainConfig,
+	TestnetID: params.LuxTestnetChainConfig,
+}
+
+// EVMForks returns the C-Chain fork times of [networkID]. Networks with a
+// chain config pinned in luxfi/geth use its fork times, which overrides
+// can't move. On other networks Shanghai and Cancun follow the
+// FeatureShanghaiEIPs and FeatureCancunEIPs gates, so proposal overrides
+// move them too.
+func (s *UpgradeSchedule) EVMForks(networkID NetworkID) EVMForks {
+	var forks EVMForks
+	forks.ChainID, _ = EVMChainIDForNetwork(networkID)
+	if c, ok := luxChainConfigs[networkID]; ok {
+		forks.DurangoTime = cloneTimestamp(c.DurangoTimestamp)
+		forks.ShanghaiTime = cloneTimestamp(c.ShanghaiTime)
+		forks.CancunTime = cloneTimestamp(c.CancunTime)
+		return forks
+	}
+
+	forks.ShanghaiTime = s.featureTimestamp(FeatureShanghaiEIPs, networkID)
+	forks.CancunTime = s.featureTimestamp(FeatureCancunEIPs, networkID)
+	if u, ok := s.Upgrade(DurangoUpgradeName); ok && u.IsScheduled(networkID) {
+		forks.DurangoTime = evmTimestamp(u.ActivationTime(networkID))
+	}
+	return forks
+}
+
+func (s *UpgradeSchedule) featureTimestamp(f Feature, networkID NetworkID) *uint64 {
+	p, ok := LookupProposal(f.Proposal())
+	if !ok {
+		return nil
+	}
+	at, ok := s.ProposalActivationTime(p, networkID)
+	if !ok {
+		return nil
+	}
+	return evmTimestamp(at)
+}
+
+// cloneTimestamp copies [ts] so that callers can't modify the original.
+func cloneTimestamp(ts *uint64) *uint64 {
+	if ts == nil {
+		return nil
+	}
+	cloned := *ts
+	return &cloned
+}
+
+// evmTimestamp converts [t] to a geth fork timestamp, mapping times before
+// the Unix epoch to genesis.
+func evmTimestamp(t time.Time) *uint64 {
+	ts := uint64(max(t.Unix(), 0))
+	return &ts
+}
+
+// Apply fills in the chain ID, if known, and the fork times of [c] that
+// aren't set yet. Fields [c] already sets and everything else in [c] are
+// left alone.
+func (f EVMForks) Apply(c *params.ChainConfig) {
+	if c.ChainID == nil && f.ChainID != 0 {
+		c.ChainID = new(big.Int).SetUint64(uint64(f.ChainID))
+	}
+	if c.DurangoTimestamp == nil {
+		c.DurangoTimestamp = cloneTimestamp(f.DurangoTime)
+	}
+	if c.ShanghaiTime == nil {
+		c.ShanghaiTime = cloneTimestamp(f.ShanghaiTime)
+	}
+	if c.CancunTime == nil {
+		c.CancunTime = cloneTimestamp(f.CancunTime)
+	}
+}
+
+// CChainForks returns the C-Chain fork times of [networkID] according to
+// DefaultUpgradeSchedule.
+func CChainForks(networkID NetworkID) EVMForks {
+	return DefaultUpgradeSchedule.EVMForks(networkID)
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/luxfi/geth/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCChainForksMatchLuxChainConfigs(t *testing.T) {
+	for networkID, expected := range map[NetworkID]*params.ChainConfig{
+		MainnetID: params.LuxMainnetChainConfig,
+		TestnetID: params.LuxTestnetChainConfig,
+	} {
+		t.Run(networkID.String(), func(t *testing.T) {
+			require := require.New(t)
+
+			forks := CChainForks(networkID)
+			require.Zero(expected.ChainID.Cmp(new(big.Int).SetUint64(uint64(forks.ChainID))))
+			require.Equal(expected.DurangoTimestamp, forks.DurangoTime)
+			require.Equal(expected.ShanghaiTime, forks.ShanghaiTime)
+			require.Equal(expected.CancunTime, forks.CancunTime)
+
+			var c params.ChainConfig
+			forks.Apply(&c)
+			require.Zero(expected.ChainID.Cmp(c.ChainID))
+			require.Equal(expected.DurangoTimestamp, c.DurangoTimestamp)
+			require.Equal(expected.ShanghaiTime, c.ShanghaiTime)
+			require.Equal(expected.CancunTime, c.CancunTime)
+		})
+	}
+
+	// The schedule agrees with mainnet staying pre-Shanghai.
+	require.False(t, FeatureEnabled(FeatureShanghaiEIPs, MainnetID, time.Now()))
+	require.False(t, FeatureEnabled(FeatureCancunEIPs, MainnetID, time.Now()))
+}
+
+func TestCChainForks(t *testing.T) {
+	require := require.New(t)
+
+	forks := CChainForks(12345)
+	require.Zero(forks.ChainID)
+	require.Zero(*forks.DurangoTime)
+	require.Zero(*forks.ShanghaiTime)
+	require.Zero(*forks.CancunTime)
+
+	c := params.ChainConfig{ChainID: big.NewInt(12345)}
+	forks.Apply(&c)
+	require.Zero(big.NewInt(12345).Cmp(c.ChainID))
+	require.Equal(forks.ShanghaiTime, c.ShanghaiTime)
+
+	// Fields the caller set are kept.
+	cancun := uint64(1)
+	c = params.ChainConfig{CancunTime: &cancun}
+	CChainForks(MainnetID).Apply(&c)
+	require.Equal(&cancun, c.CancunTime)
+	require.Nil(c.ShanghaiTime)
+	require.Zero(big.NewInt(int64(MainnetChainID)).Cmp(c.ChainID))
+}
+
+func TestEVMForksFollowOverrides(t *testing.T) {
+	require := require.New(t)
+
+	cancun := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	s, err := DefaultUpgradeSchedule.WithOverrides(LocalID, UpgradeOverrides{
+		Proposals: map[ProposalID]time.Time{ACPID(131): cancun},
+	})
+	require.NoError(err)
+
+	forks := s.EVMForks(LocalID)
+	require.Zero(*forks.ShanghaiTime)
+	require.Equal(uint64(cancun.Unix()), *forks.CancunTime)
+}
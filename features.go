@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnknownFeature = errors.New("unknown feature")
+
+	_ encoding.TextMarshaler   = Feature(0)
+	_ encoding.TextUnmarshaler = (*Feature)(nil)
+)
+
+// Feature is a named protocol feature gate. Check features instead of raw
+// LP/ACP numbers, e.g. FeatureEnabled(FeatureDynamicFees, networkID, t)
+// rather than ActivatedACPs.Contains(103). The zero Feature is invalid.
+type Feature uint8
+
+const (
+	FeaturePChainNativeTransfers Feature = iota + 1
+	FeatureShanghaiEIPs
+	FeatureVMApplicationErrors
+	FeatureWarpEVM
+	FeatureChainOwnershipTransfer
+	FeatureRemovePendingStakers
+	FeatureDisableLegacyStakingTxs
+	FeatureReinventingChains
+	FeatureDynamicFees
+	FeatureWarpSignatureRequest
+	FeatureBaseFeeReduction
+	FeatureCancunEIPs
+	FeaturePChainHeightContext
+	FeatureDynamicEVMGasLimit
+
+	numFeatures = iota
+)
+
+// features maps every Feature to its name and the proposal that introduces
+// it. The zero Feature is invalid and has no entry.
+var features = [numFeatures + 1]struct {
+	name     string
+	proposal ProposalID
+}{
+	FeaturePChainNativeTransfers:   {"PChainNativeTransfers", ACPID(23)},
+	FeatureShanghaiEIPs:            {"ShanghaiEIPs", ACPID(24)},
+	FeatureVMApplicationErrors:     {"VMApplicationErrors", ACPID(25)},
+	FeatureWarpEVM:                 {"WarpEVM", ACPID(30)},
+	FeatureChainOwnershipTransfer:  {"ChainOwnershipTransfer", ACPID(31)},
+	FeatureRemovePendingStakers:    {"RemovePendingStakers", ACPID(41)},
+	FeatureDisableLegacyStakingTxs: {"DisableLegacyStakingTxs", ACPID(62)},
+	FeatureReinventingChains:       {"ReinventingChains", ACPID(77)},
+	FeatureDynamicFees:             {"DynamicFees", ACPID(103)},
+	FeatureWarpSignatureRequest:    {"WarpSignatureRequest", ACPID(118)},
+	FeatureBaseFeeReduction:        {"BaseFeeReduction", ACPID(125)},
+	FeatureCancunEIPs:              {"CancunEIPs", ACPID(131)},
+	FeaturePChainHeightContext:     {"PChainHeightContext", ACPID(151)},
+	FeatureDynamicEVMGasLimit:      {"DynamicEVMGasLimit", LPID(176)},
+}
+
+// Features returns every feature in declaration order.
+func Features() []Feature {
+	fs := make([]Feature, numFeatures)
+	for i := range fs {
+		fs[i] = Feature(i + 1)
+	}
+	return fs
+}
+
+// Valid reports whether [f] is a declared feature. The zero Feature isn't.
+func (f Feature) Valid() bool {
+	return f != 0 && int(f) <= numFeatures
+}
+
+func (f Feature) String() string {
+	if !f.Valid() {
+		return "unknown"
+	}
+	return features[f].name
+}
+
+// Proposal returns the LP or ACP that introduces [f].
+func (f Feature) Proposal() ProposalID {
+	if !f.Valid() {
+		return ProposalID{}
+	}
+	return features[f].proposal
+}
+
+// FeatureFromString returns the feature named [name], ignoring case. It never
+// returns the zero Feature.
+func FeatureFromString(name string) (Feature, error) {
+	for _, f := range Features() {
+		if strings.EqualFold(f.String(), name) {
+			return f, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownFeature, name)
+}
+
+// MarshalText writes the feature's name.
+func (f Feature) MarshalText() ([]byte, error) {
+	if !f.Valid() {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownFeature, f)
+	}
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText parses the feature as FeatureFromString does, so the zero
+// Feature is rejected.
+func (f *Feature) UnmarshalText(text []byte) error {
+	parsed, err := FeatureFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
+
+// FeatureState is the state of one feature gate on a network at a time.
+type FeatureState struct {
+	Feature    Feature    `json:"feature"`
+	Proposal   ProposalID `json:"proposal"`
+	Enabled    bool       `json:"enabled"`
+	Scheduled  bool       `json:"scheduled"`
+	Activation time.Time  `json:"activation,omitzero"`
+}
+
+// FeatureStates is a dump of every feature gate, for debugging.
+type FeatureStates []FeatureState
+
+func (s FeatureStates) String() string {
+	var sb strings.Builder
+	for _, state := range s {
+		enabled := "disabled"
+		if state.Enabled {
+			enabled = "enabled"
+		}
+		fmt.Fprintf(&sb, "%-24s %-8s %-8s %s\n",
+			state.Feature,
+			state.Proposal,
+			enabled,
+			formatActivation(state.Scheduled, state.Activation),
+		)
+	}
+	return sb.String()
+}
+
+// FeatureEnabled reports whether [f] is enabled on [networkID] at [t].
+func (s *UpgradeSchedule) FeatureEnabled(f Feature, networkID NetworkID, t time.Time) bool {
+	return s.IsProposalActive(f.Proposal(), networkID, t)
+}
+
+// FeatureStates returns the state of every feature on [networkID] at [t].
+func (s *UpgradeSchedule) FeatureStates(networkID NetworkID, t time.Time) FeatureStates {
+	states := make(FeatureStates, 0, numFeatures)
+	for _, f := range Features() {
+		state := FeatureState{
+			Feature:  f,
+			Proposal: f.Proposal(),
+		}
+		if p, ok := LookupProposal(state.Proposal); ok {
+			state.Activation, state.Scheduled = s.ProposalActivationTime(p, networkID)
+			state.Enabled = state.Scheduled && !t.Before(state.Activation)
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+// FeatureEnabled reports whether [f] is enabled on [networkID] at [t]
+// according to DefaultUpgradeSchedule.
+func FeatureEnabled(f Feature, networkID NetworkID, t time.Time) bool {
+	return DefaultUpgradeSchedule.FeatureEnabled(f, networkID, t)
+}
+
+// FeatureStatesAt returns the state of every feature on [networkID] at [t]
+// according to DefaultUpgradeSchedule.
+func FeatureStatesAt(networkID NetworkID, t time.Time) FeatureStates {
+	return DefaultUpgradeSchedule.FeatureStates(networkID, t)
+}
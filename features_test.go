@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureProposals(t *testing.T) {
+	require := require.New(t)
+
+	for _, f := range Features() {
+		_, ok := LookupProposal(f.Proposal())
+		require.True(ok, f)
+
+		parsed, err := FeatureFromString(f.String())
+		require.NoError(err)
+		require.Equal(f, parsed)
+	}
+
+	_, err := FeatureFromString("Teleport")
+	require.ErrorIs(err, ErrUnknownFeature)
+	require.Equal("unknown", Feature(numFeatures+1).String())
+}
+
+func TestFeatureZeroValueInvalid(t *testing.T) {
+	require := require.New(t)
+
+	var f Feature
+	require.False(f.Valid())
+	require.Equal("unknown", f.String())
+	require.Equal(ProposalID{}, f.Proposal())
+
+	_, err := f.MarshalText()
+	require.ErrorIs(err, ErrUnknownFeature)
+
+	_, err = FeatureFromString(f.String())
+	require.ErrorIs(err, ErrUnknownFeature)
+	require.ErrorIs(json.Unmarshal([]byte(`"unknown"`), &f), ErrUnknownFeature)
+	require.ErrorIs(json.Unmarshal([]byte(`""`), &f), ErrUnknownFeature)
+
+	require.NoError(json.Unmarshal([]byte(`"dynamicfees"`), &f))
+	require.Equal(FeatureDynamicFees, f)
+}
+
+func TestFeatureEnabled(t *testing.T) {
+	require := require.New(t)
+
+	quasarMainnet := QuasarActivationTime[MainnetID]
+	require.False(FeatureEnabled(FeatureDynamicFees, MainnetID, quasarMainnet.Add(-time.Second)))
+	require.True(FeatureEnabled(FeatureDynamicFees, MainnetID, quasarMainnet))
+	require.True(FeatureEnabled(FeatureChainOwnershipTransfer, MainnetID, quasarMainnet.Add(-time.Second)))
+	require.True(FeatureEnabled(FeatureShanghaiEIPs, TestnetID, GenesisActivationTime))
+	require.True(FeatureEnabled(FeatureDynamicFees, LocalID, GenesisActivationTime))
+
+	states := FeatureStatesAt(MainnetID, quasarMainnet.Add(-time.Second))
+	require.Len(states, len(Features()))
+	unscheduled := []Feature{FeatureShanghaiEIPs, FeatureCancunEIPs, FeatureDynamicEVMGasLimit}
+	for _, state := range states {
+		require.Equal(!slices.Contains(unscheduled, state.Feature), state.Scheduled, state.Feature)
+		require.Equal(FeatureEnabled(state.Feature, MainnetID, quasarMainnet.Add(-time.Second)), state.Enabled)
+	}
+	require.Contains(states.String(), "DynamicFees              ACP-103  disabled 2024-12-16T17:00:00Z")
+
+	b, err := json.Marshal(states[FeatureDynamicFees-1])
+	require.NoError(err)
+	require.JSONEq(`{
+		"feature": "DynamicFees",
+		"proposal": "ACP-103",
+		"enabled": false,
+		"scheduled": true,
+		"activation": "2024-12-16T17:00:00Z"
+	}`, string(b))
+}
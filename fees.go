@@ -0,0 +1,323 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"time"
+)
+
+// Denominations of C-Chain gas prices, which use 18 decimals.
+const (
+	Wei  uint64 = 1
+	GWei uint64 = 1_000_000_000 * Wei
+)
+
+// FeeWindowSize is the length, in seconds, of the rolling window that the
+// base fee tracks before ACP-176.
+const FeeWindowSize = 10
+
+var (
+	ErrInvalidFeeParams      = errors.New("invalid fee params")
+	ErrInvalidParentFeeState = errors.New("invalid parent fee state")
+)
+
+// FeeParams configures the C-Chain base fee.
+//
+// Before ACP-176, the base fee moves towards TargetGas, the target gas used
+// per FeeWindowSize second rolling window, by at most
+// 1/BaseFeeChangeDenominator per window and never drops below MinBaseFee.
+//
+// ACP-176 replaces the window with a gas price exponential in the gas excess.
+// Its params set TargetPerSecond and TargetToPriceUpdateConversion; MinBaseFee
+// is then the minimum gas price.
+type FeeParams struct {
+	GasLimit                 uint64 `json:"gasLimit,omitempty"`
+	TargetGas                uint64 `json:"targetGas,omitempty"`
+	MinBaseFee               uint64 `json:"minBaseFee"` // wei
+	BaseFeeChangeDenominator uint64 `json:"baseFeeChangeDenominator,omitempty"`
+
+	TargetPerSecond               uint64 `json:"targetPerSecond,omitempty"`
+	TargetToPriceUpdateConversion uint64 `json:"targetToPriceUpdateConversion,omitempty"`
+}
+
+// IsACP176 reports whether [p] uses the ACP-176 gas price.
+func (p FeeParams) IsACP176() bool {
+	return p.TargetPerSecond != 0
+}
+
+// Verify checks that [p] can be used to compute base fees. The zero FeeParams
+// fails.
+func (p FeeParams) Verify() error {
+	if p.IsACP176() {
+		switch {
+		case p.MinBaseFee == 0:
+			return fmt.Errorf("%w: min gas price must be positive", ErrInvalidFeeParams)
+		case p.TargetToPriceUpdateConversion == 0:
+			return fmt.Errorf("%w: target to price update conversion must be positive", ErrInvalidFeeParams)
+		default:
+			return nil
+		}
+	}
+	switch {
+	case p.TargetGas == 0:
+		return fmt.Errorf("%w: target gas must be positive", ErrInvalidFeeParams)
+	case p.BaseFeeChangeDenominator == 0:
+		return fmt.Errorf("%w: base fee change denominator must be positive", ErrInvalidFeeParams)
+	default:
+		return nil
+	}
+}
+
+// FeeWindow is the gas used in each of the last FeeWindowSize seconds, oldest
+// first, as a C-Chain block header records it before ACP-176.
+type FeeWindow [FeeWindowSize]uint64
+
+// roll drops the [seconds] oldest entries of [w].
+func (w FeeWindow) roll(seconds uint64) FeeWindow {
+	var rolled FeeWindow
+	if seconds < FeeWindowSize {
+		copy(rolled[:], w[seconds:])
+	}
+	return rolled
+}
+
+// Sum returns the gas used in the window, capped at math.MaxUint64.
+func (w FeeWindow) Sum() uint64 {
+	var sum uint64
+	for _, gas := range w {
+		sum = saturatingAdd(sum, gas)
+	}
+	return sum
+}
+
+// ParentFeeState is what the base fee of a block depends on in its parent
+// before ACP-176.
+type ParentFeeState struct {
+	Timestamp uint64 // seconds
+	BaseFee   *big.Int
+	Window    FeeWindow
+	// GasUsed is the gas the parent used, including the gas of its atomic
+	// transactions.
+	GasUsed uint64
+}
+
+// NextBaseFee returns the window and base fee of a block at [timestamp]
+// whose parent is [parent], as the C-Chain computes them before ACP-176:
+// the window rolls forward to [timestamp] and records the parent's gas, then
+// the base fee moves by 1/BaseFeeChangeDenominator of its relative distance
+// from TargetGas, by at least 1 wei. If whole windows passed without blocks,
+// the decrease is applied once for each of them. Block gas costs aren't part
+// of the window since Apricot Phase 5. It must not be used with ACP-176
+// params.
+func (p FeeParams) NextBaseFee(parent ParentFeeState, timestamp uint64) (FeeWindow, *big.Int, error) {
+	switch {
+	case parent.BaseFee == nil:
+		return FeeWindow{}, nil, fmt.Errorf("%w: no base fee", ErrInvalidParentFeeState)
+	case timestamp < parent.Timestamp:
+		return FeeWindow{}, nil, fmt.Errorf("%w: timestamp %d before parent timestamp %d",
+			ErrInvalidParentFeeState, timestamp, parent.Timestamp)
+	}
+
+	elapsed := timestamp - parent.Timestamp
+	window := parent.Window.roll(elapsed)
+	if elapsed < FeeWindowSize {
+		slot := FeeWindowSize - 1 - elapsed
+		window[slot] = saturatingAdd(window[slot], parent.GasUsed)
+	}
+
+	baseFee := new(big.Int).Set(parent.BaseFee)
+	used := window.Sum()
+	if used == p.TargetGas {
+		return window, baseFee, nil
+	}
+
+	target := new(big.Int).SetUint64(p.TargetGas)
+	delta := new(big.Int)
+	if used > p.TargetGas {
+		// delta = max(1, parentBaseFee * (used - target) / target / denominator)
+		delta.SetUint64(used - p.TargetGas)
+	} else {
+		// delta = max(1, parentBaseFee * (target - used) / target / denominator)
+		delta.SetUint64(p.TargetGas - used)
+	}
+	delta.Mul(delta, parent.BaseFee)
+	delta.Div(delta, target)
+	delta.Div(delta, new(big.Int).SetUint64(p.BaseFeeChangeDenominator))
+	if delta.Sign() == 0 {
+		delta.SetUint64(1)
+	}
+
+	if used > p.TargetGas {
+		baseFee.Add(baseFee, delta)
+	} else {
+		if elapsed > FeeWindowSize {
+			delta.Mul(delta, new(big.Int).SetUint64(elapsed/FeeWindowSize))
+		}
+		baseFee.Sub(baseFee, delta)
+	}
+	if minBaseFee := new(big.Int).SetUint64(p.MinBaseFee); baseFee.Cmp(minBaseFee) < 0 {
+		baseFee = minBaseFee
+	}
+	return window, baseFee, nil
+}
+
+// GasPrice returns the ACP-176 gas price at [excess] gas:
+// MinBaseFee * e^(excess / K), with K = TargetToPriceUpdateConversion *
+// TargetPerSecond, capped at math.MaxUint64 wei. e^x is approximated by a
+// Taylor series, as in EIP-4844.
+func (p FeeParams) GasPrice(excess uint64) *big.Int {
+	k := new(big.Int).SetUint64(p.TargetToPriceUpdateConversion)
+	k.Mul(k, new(big.Int).SetUint64(p.TargetPerSecond))
+	x := new(big.Int).SetUint64(excess)
+
+	var (
+		maxPrice = new(big.Int).SetUint64(math.MaxUint64)
+		limit    = new(big.Int).Mul(maxPrice, k)
+		output   = new(big.Int)
+		accum    = new(big.Int).Mul(new(big.Int).SetUint64(p.MinBaseFee), k)
+	)
+	for i := int64(1); accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+		if output.Cmp(limit) >= 0 {
+			return maxPrice
+		}
+
+		accum.Mul(accum, x)
+		accum.Div(accum, k)
+		accum.Div(accum, big.NewInt(i))
+	}
+	return output.Div(output, k)
+}
+
+// NextGasPrice returns the ACP-176 gas excess and gas price of a block
+// [elapsed] seconds after a parent that started at [parentExcess] and used
+// [gasUsed] gas. The excess grows by the gas used and shrinks by
+// TargetPerSecond for every elapsed second, never below 0. It assumes the
+// target doesn't change between the two blocks.
+func (p FeeParams) NextGasPrice(parentExcess, gasUsed, elapsed uint64) (uint64, *big.Int) {
+	excess := saturatingAdd(parentExcess, gasUsed)
+	excess -= min(excess, saturatingMul(p.TargetPerSecond, elapsed))
+	return excess, p.GasPrice(excess)
+}
+
+func saturatingAdd(a, b uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+	return sum
+}
+
+func saturatingMul(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+	return lo
+}
+
+// ProposalFeeParams are the fee params introduced by a proposal. They apply
+// to every network the proposal activates on.
+type ProposalFeeParams struct {
+	// Proposal introduces the params. The zero ProposalID applies from
+	// genesis.
+	Proposal ProposalID
+	Params   FeeParams
+}
+
+// FeeSchedule lists fee params in activation order. The params of the last
+// active entry apply.
+//
+// Lux networks have published no C-Chain fee params of their own; the values
+// below are those of the upstream C-Chain (coreth) for each proposal.
+var FeeSchedule = []ProposalFeeParams{
+	{
+		// Apricot Phase 5 target and change denominator, Apricot Phase 4
+		// minimum base fee and the Cortina gas limit. The EIP-1559 header
+		// check in luxfi/geth also uses the 25 GWei floor and 36.
+		Params: FeeParams{
+			GasLimit:                 15_000_000,
+			TargetGas:                15_000_000,
+			MinBaseFee:               25 * GWei,
+			BaseFeeChangeDenominator: 36,
+		},
+	},
+	{
+		// ACP-125 lowers the minimum base fee from 25 to 1 GWei and changes
+		// nothing else.
+		Proposal: ACPID(125),
+		Params: FeeParams{
+			GasLimit:                 15_000_000,
+			TargetGas:                15_000_000,
+			MinBaseFee:               1 * GWei,
+			BaseFeeChangeDenominator: 36,
+		},
+	},
+	{
+		// LP-176, the Lux port of ACP-176, switches to ACP-176 pricing.
+		// ACP-176 sets the minimum gas price to 1 wei, K to 87 * T and
+		// starts T at its minimum of 1M gas per second.
+		Proposal: LPID(176),
+		Params: FeeParams{
+			MinBaseFee:                    1 * Wei,
+			TargetPerSecond:               1_000_000,
+			TargetToPriceUpdateConversion: 87,
+		},
+	},
+}
+
+// FeeParamsAt returns the fee params in effect on [networkID] at [t]. If no
+// entry of FeeSchedule is in effect, it returns the zero FeeParams, which
+// fail Verify.
+func (s *UpgradeSchedule) FeeParamsAt(networkID NetworkID, t time.Time) FeeParams {
+	var params FeeParams
+	for _, p := range FeeSchedule {
+		if p.Proposal == (ProposalID{}) || s.IsProposalActive(p.Proposal, networkID, t) {
+			params = p.Params
+		}
+	}
+	return params
+}
+
+// CurrentFeeParams returns the fee params in effect on [networkID] at [t]
+// according to DefaultUpgradeSchedule.
+func CurrentFeeParams(networkID NetworkID, t time.Time) FeeParams {
+	return DefaultUpgradeSchedule.FeeParamsAt(networkID, t)
+}
+
+// NextBaseFee returns the window and base fee of the block at [timestamp]
+// on [networkID], as FeeParams.NextBaseFee does, according to
+// DefaultUpgradeSchedule. It errors if the params in effect are invalid or
+// use ACP-176.
+func NextBaseFee(networkID NetworkID, timestamp time.Time, parent ParentFeeState) (FeeWindow, *big.Int, error) {
+	params := CurrentFeeParams(networkID, timestamp)
+	if err := params.Verify(); err != nil {
+		return FeeWindow{}, nil, err
+	}
+	if params.IsACP176() {
+		return FeeWindow{}, nil, fmt.Errorf("%w: ACP-176 prices follow the gas excess; use NextGasPrice", ErrInvalidFeeParams)
+	}
+	return params.NextBaseFee(parent, uint64(timestamp.Unix()))
+}
+
+// NextGasPrice returns the ACP-176 gas excess and gas price of the block at
+// [timestamp] on [networkID], as FeeParams.NextGasPrice does, according to
+// DefaultUpgradeSchedule. It errors if the params in effect are invalid or
+// predate ACP-176.
+func NextGasPrice(networkID NetworkID, timestamp time.Time, parentExcess, gasUsed, elapsed uint64) (uint64, *big.Int, error) {
+	params := CurrentFeeParams(networkID, timestamp)
+	if err := params.Verify(); err != nil {
+		return 0, nil, err
+	}
+	if !params.IsACP176() {
+		return 0, nil, fmt.Errorf("%w: base fees follow the gas window; use NextBaseFee", ErrInvalidFeeParams)
+	}
+	excess, price := params.NextGasPrice(parentExcess, gasUsed, elapsed)
+	return excess, price, nil
+}
@@ -0,0 +1,293 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeScheduleVerify(t *testing.T) {
+	for _, p := range FeeSchedule {
+		if p.Proposal != (ProposalID{}) {
+			_, ok := LookupProposal(p.Proposal)
+			require.True(t, ok, p.Proposal)
+		}
+		require.NoError(t, p.Params.Verify(), p.Proposal)
+	}
+
+	require.ErrorIs(t, FeeParams{}.Verify(), ErrInvalidFeeParams)
+	require.ErrorIs(t, FeeParams{GasLimit: 1}.Verify(), ErrInvalidFeeParams)
+	require.ErrorIs(t, FeeParams{GasLimit: 1, TargetGas: 1}.Verify(), ErrInvalidFeeParams)
+	require.ErrorIs(t, FeeParams{TargetPerSecond: 1, TargetToPriceUpdateConversion: 1}.Verify(), ErrInvalidFeeParams)
+	require.ErrorIs(t, FeeParams{TargetPerSecond: 1, MinBaseFee: 1}.Verify(), ErrInvalidFeeParams)
+}
+
+func TestFeeParamsNextBaseFee(t *testing.T) {
+	params := FeeParams{
+		GasLimit:                 2_000,
+		TargetGas:                1_000,
+		MinBaseFee:               100,
+		BaseFeeChangeDenominator: 8,
+	}
+	const parentTimestamp = 1_000
+	tests := []struct {
+		name           string
+		parent         ParentFeeState
+		timestamp      uint64
+		expectedWindow FeeWindow
+		expected       uint64
+		expectedErr    error
+	}{
+		{
+			name: "at target",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(1_000),
+				GasUsed:   1_000,
+			},
+			timestamp:      parentTimestamp + 1,
+			expectedWindow: FeeWindow{8: 1_000},
+			expected:       1_000,
+		},
+		{
+			name: "over target",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(1_000),
+				GasUsed:   2_000,
+			},
+			timestamp:      parentTimestamp + 1,
+			expectedWindow: FeeWindow{8: 2_000},
+			expected:       1_125,
+		},
+		{
+			name: "under target",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(1_000),
+			},
+			timestamp: parentTimestamp + 1,
+			expected:  875,
+		},
+		{
+			name: "window rolls",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(1_000),
+				Window:    FeeWindow{0: 400, 8: 300, 9: 200},
+				GasUsed:   500,
+			},
+			timestamp:      parentTimestamp + 2,
+			expectedWindow: FeeWindow{6: 300, 7: 700},
+			expected:       1_000,
+		},
+		{
+			name: "same second",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(1_000),
+				Window:    FeeWindow{9: 500},
+				GasUsed:   1_500,
+			},
+			timestamp:      parentTimestamp,
+			expectedWindow: FeeWindow{9: 2_000},
+			expected:       1_125,
+		},
+		{
+			name: "increase by at least 1",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(100),
+				GasUsed:   1_001,
+			},
+			timestamp:      parentTimestamp + 1,
+			expectedWindow: FeeWindow{8: 1_001},
+			expected:       101,
+		},
+		{
+			name: "decrease by at least 1",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(1_000),
+				GasUsed:   999,
+			},
+			timestamp:      parentTimestamp + 1,
+			expectedWindow: FeeWindow{8: 999},
+			expected:       999,
+		},
+		{
+			name: "decrease once per empty window",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(1_000),
+				Window:    FeeWindow{9: 5_000},
+				GasUsed:   5_000,
+			},
+			timestamp: parentTimestamp + 25,
+			expected:  750,
+		},
+		{
+			name: "floored at min base fee",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(101),
+			},
+			timestamp: parentTimestamp + 1,
+			expected:  100,
+		},
+		{
+			name: "no parent base fee",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+			},
+			timestamp:   parentTimestamp + 1,
+			expectedErr: ErrInvalidParentFeeState,
+		},
+		{
+			name: "before parent",
+			parent: ParentFeeState{
+				Timestamp: parentTimestamp,
+				BaseFee:   big.NewInt(1_000),
+			},
+			timestamp:   parentTimestamp - 1,
+			expectedErr: ErrInvalidParentFeeState,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			window, next, err := params.NextBaseFee(test.parent, test.timestamp)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expectedWindow, window)
+			require.Equal(new(big.Int).SetUint64(test.expected), next)
+		})
+	}
+}
+
+func TestFeeWindowSum(t *testing.T) {
+	require.Equal(t, uint64(math.MaxUint64), FeeWindow{0: math.MaxUint64, 1: 1}.Sum())
+}
+
+func TestFeeParamsGasPrice(t *testing.T) {
+	require := require.New(t)
+
+	params := FeeParams{
+		MinBaseFee:                    1 * GWei,
+		TargetPerSecond:               1_000_000,
+		TargetToPriceUpdateConversion: 87,
+	}
+	require.Equal(new(big.Int).SetUint64(1*GWei), params.GasPrice(0))
+
+	// At an excess of K the price is e times the minimum.
+	k := params.TargetToPriceUpdateConversion * params.TargetPerSecond
+	require.InDelta(2_718_281_828, params.GasPrice(k).Uint64(), 1)
+
+	excess, price := params.NextGasPrice(k, 2_000_000, 1)
+	require.Equal(k+1_000_000, excess)
+	require.Equal(params.GasPrice(excess), price)
+
+	excess, price = params.NextGasPrice(5_000_000, 0, 10)
+	require.Zero(excess)
+	require.Equal(new(big.Int).SetUint64(1*GWei), price)
+
+	excess, price = params.NextGasPrice(math.MaxUint64, 1, 0)
+	require.Equal(uint64(math.MaxUint64), excess)
+	require.Equal(new(big.Int).SetUint64(math.MaxUint64), price)
+	excess, _ = params.NextGasPrice(math.MaxUint64, 0, math.MaxUint64)
+	require.Zero(excess)
+}
+
+func TestCurrentFeeParams(t *testing.T) {
+	require := require.New(t)
+
+	quasarMainnet := QuasarActivationTime[MainnetID]
+	require.Equal(25*GWei, CurrentFeeParams(MainnetID, quasarMainnet.Add(-time.Second)).MinBaseFee)
+	require.Equal(1*GWei, CurrentFeeParams(MainnetID, quasarMainnet).MinBaseFee)
+	require.Equal(1*GWei, CurrentFeeParams(12345, GenesisActivationTime).MinBaseFee)
+
+	parent := ParentFeeState{
+		Timestamp: uint64(quasarMainnet.Unix()) - 1,
+		BaseFee:   new(big.Int).SetUint64(1 * GWei),
+	}
+	_, next, err := NextBaseFee(MainnetID, quasarMainnet, parent)
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(1*GWei), next)
+
+	_, _, err = NextGasPrice(MainnetID, quasarMainnet, 0, 0, 1)
+	require.ErrorIs(err, ErrInvalidFeeParams)
+
+	_, price, err := NextGasPrice(LocalID, GenesisActivationTime, 0, 0, 1)
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(1*Wei), price)
+	_, _, err = NextBaseFee(LocalID, GenesisActivationTime, parent)
+	require.ErrorIs(err, ErrInvalidFeeParams)
+}
+
+func TestFeeParamsFollowProposalOverrides(t *testing.T) {
+	require := require.New(t)
+
+	quasarMainnet := QuasarActivationTime[MainnetID]
+	s, err := DefaultUpgradeSchedule.WithOverrides(MainnetID, UpgradeOverrides{
+		Proposals: map[ProposalID]time.Time{ACPID(125): quasarMainnet.Add(time.Hour)},
+	})
+	require.NoError(err)
+	require.Equal(25*GWei, s.FeeParamsAt(MainnetID, quasarMainnet).MinBaseFee)
+	require.Equal(1*GWei, s.FeeParamsAt(MainnetID, quasarMainnet.Add(time.Hour)).MinBaseFee)
+
+	acp176 := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	s, err = DefaultUpgradeSchedule.WithOverrides(MainnetID, UpgradeOverrides{
+		Proposals: map[ProposalID]time.Time{LPID(176): acp176},
+	})
+	require.NoError(err)
+	require.False(s.FeeParamsAt(MainnetID, acp176.Add(-time.Second)).IsACP176())
+	params := s.FeeParamsAt(MainnetID, acp176)
+	require.True(params.IsACP176())
+	require.Equal(1*Wei, params.MinBaseFee)
+}
+
+func TestFeeParamsMatchFeatureGates(t *testing.T) {
+	networkIDs := []NetworkID{MainnetID, TestnetID, DevnetID, LocalID, 12345}
+	times := []time.Time{
+		GenesisActivationTime,
+		QuasarActivationTime[TestnetID],
+		QuasarActivationTime[MainnetID],
+		time.Now(),
+	}
+	for _, networkID := range networkIDs {
+		for _, at := range times {
+			params := CurrentFeeParams(networkID, at)
+			require.Equal(t, FeatureEnabled(FeatureDynamicEVMGasLimit, networkID, at), params.IsACP176(), "%s at %s", networkID, at)
+			if !params.IsACP176() {
+				reduced := FeatureEnabled(FeatureBaseFeeReduction, networkID, at)
+				require.Equal(t, reduced, params.MinBaseFee == 1*GWei, "%s at %s", networkID, at)
+			}
+		}
+	}
+}
+
+func TestFeeParamsAtEmptySchedule(t *testing.T) {
+	require := require.New(t)
+
+	schedule := FeeSchedule
+	t.Cleanup(func() { FeeSchedule = schedule })
+	FeeSchedule = nil
+
+	params := CurrentFeeParams(MainnetID, time.Now())
+	require.Equal(FeeParams{}, params)
+	require.ErrorIs(params.Verify(), ErrInvalidFeeParams)
+
+	_, _, err := NextBaseFee(MainnetID, time.Now(), ParentFeeState{})
+	require.ErrorIs(err, ErrInvalidFeeParams)
+	_, _, err = NextGasPrice(MainnetID, time.Now(), 0, 0, 0)
+	require.ErrorIs(err, ErrInvalidFeeParams)
+}
@@ -3,11 +3,13 @@ module github.com/luxfi/constants
 go 1.26.4
 
 require (
+	github.com/klauspost/compress v1.18.2
 	github.com/luxfi/crypto v1.19.6
 	github.com/luxfi/geth v1.16.69
 	github.com/luxfi/ids v1.2.9
 	github.com/luxfi/math v1.2.2
 	github.com/stretchr/testify v1.11.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -27,5 +29,4 @@ require (
 	golang.org/x/exp v0.0.0-20260312153236-7ab1446f8b90 // indirect
 	golang.org/x/sys v0.42.0 // indirect
 	gonum.org/v1/gonum v0.17.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
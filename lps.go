@@ -6,50 +6,361 @@
 
 package constants
 
-import "github.com/luxfi/math/set"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/luxfi/math/set"
+)
+
+var (
+	ErrInvalidProposalID     = errors.New("invalid proposal ID")
+	ErrInvalidProposalStatus = errors.New("invalid proposal status")
+	ErrUnknownProposal       = errors.New("unknown proposal")
+)
+
+// ProposalKind is the process a protocol proposal went through.
+type ProposalKind uint8
+
+const (
+	// LP is a Lux Protocol proposal.
+	LP ProposalKind = iota
+	// ACP is an upstream Avalanche Community Proposal adopted by Lux.
+	ACP
+)
+
+func (k ProposalKind) String() string {
+	switch k {
+	case LP:
+		return "LP"
+	case ACP:
+		return "ACP"
+	default:
+		return "unknown"
+	}
+}
+
+// ProposalStatus is the lifecycle stage of a proposal at the time of release.
+type ProposalStatus uint8
+
+const (
+	ProposalProposed ProposalStatus = iota
+	// ProposalImplementable proposals are accepted but not activated yet.
+	ProposalImplementable
+	ProposalActivated
+)
+
+func (s ProposalStatus) String() string {
+	switch s {
+	case ProposalProposed:
+		return "proposed"
+	case ProposalImplementable:
+		return "implementable"
+	case ProposalActivated:
+		return "activated"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText writes the status as String does.
+func (s ProposalStatus) MarshalText() ([]byte, error) {
+	if s > ProposalActivated {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidProposalStatus, s)
+	}
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText parses a status written by MarshalText.
+func (s *ProposalStatus) UnmarshalText(text []byte) error {
+	for status := ProposalProposed; status <= ProposalActivated; status++ {
+		if string(text) == status.String() {
+			*s = status
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidProposalStatus, text)
+}
+
+// ProposalID identifies a proposal, e.g. ACP-77. LP and ACP numbers overlap,
+// so the kind is part of the identity.
+type ProposalID struct {
+	Kind   ProposalKind
+	Number uint32
+}
+
+// LPID returns the ID of LP-[number].
+func LPID(number uint32) ProposalID {
+	return ProposalID{Kind: LP, Number: number}
+}
+
+// ACPID returns the ID of ACP-[number].
+func ACPID(number uint32) ProposalID {
+	return ProposalID{Kind: ACP, Number: number}
+}
+
+func (id ProposalID) String() string {
+	return fmt.Sprintf("%s-%d", id.Kind, id.Number)
+}
+
+// ParseProposalID parses "LP-176" or "ACP-77", ignoring case.
+func ParseProposalID(s string) (ProposalID, error) {
+	kindStr, numberStr, ok := strings.Cut(strings.ToUpper(strings.TrimSpace(s)), "-")
+	if !ok {
+		return ProposalID{}, fmt.Errorf("%w: %q", ErrInvalidProposalID, s)
+	}
+	var kind ProposalKind
+	switch kindStr {
+	case LP.String():
+		kind = LP
+	case ACP.String():
+		kind = ACP
+	default:
+		return ProposalID{}, fmt.Errorf("%w: %q", ErrInvalidProposalID, s)
+	}
+	number, err := strconv.ParseUint(numberStr, 10, 32)
+	if err != nil {
+		return ProposalID{}, fmt.Errorf("%w: %q", ErrInvalidProposalID, s)
+	}
+	return ProposalID{Kind: kind, Number: uint32(number)}, nil
+}
+
+// MarshalText writes the ID as String does, so it can key JSON objects.
+func (id ProposalID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText parses the ID as ParseProposalID does.
+func (id *ProposalID) UnmarshalText(text []byte) error {
+	parsed, err := ParseProposalID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// Proposal is a catalog entry describing an LP or ACP.
+type Proposal struct {
+	ID     ProposalID     `json:"id"`
+	Title  string         `json:"title"`
+	URL    string         `json:"url,omitempty"`
+	Status ProposalStatus `json:"status"`
+	// Upgrade is the network upgrade that includes the proposal.
+	Upgrade string `json:"upgrade"`
+	// Activation overrides the upgrade's activation time on some networks.
+	Activation map[NetworkID]time.Time `json:"activation,omitempty"`
+}
+
+// ActivationTime returns when [p] activates on [networkID] according to
+// DefaultUpgradeSchedule.
+func (p Proposal) ActivationTime(networkID NetworkID) (time.Time, bool) {
+	return DefaultUpgradeSchedule.ProposalActivationTime(p, networkID)
+}
+
+// IsActive reports whether [p] is active on [networkID] at [t].
+func (p Proposal) IsActive(networkID NetworkID, t time.Time) bool {
+	at, ok := p.ActivationTime(networkID)
+	return ok && !t.Before(at)
+}
+
+// Proposals is the catalog of LPs and ACPs known to this release.
+//
+// See: https://github.com/orgs/luxfi/projects/1
+var Proposals = []Proposal{
+	// Durango:
+	{
+		ID:      ACPID(23),
+		Title:   "P-Chain Native Transfers",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/23-p-chain-native-transfers/README.md",
+		Status:  ProposalActivated,
+		Upgrade: DurangoUpgradeName,
+	},
+	{
+		ID:      ACPID(24),
+		Title:   "Shanghai EIPs",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/24-shanghai-eips/README.md",
+		Status:  ProposalActivated,
+		Upgrade: DurangoUpgradeName,
+		// params.LuxMainnetChainConfig in luxfi/geth keeps mainnet
+		// pre-Shanghai so that historical state roots still verify.
+		Activation: map[NetworkID]time.Time{
+			MainnetID: UnscheduledActivationTime,
+		},
+	},
+	{
+		ID:      ACPID(25),
+		Title:   "VM Application Errors",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/25-vm-application-errors/README.md",
+		Status:  ProposalActivated,
+		Upgrade: DurangoUpgradeName,
+	},
+	{
+		ID:      ACPID(30),
+		Title:   "Lux Warp x EVM",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/30-lux-warp-x-evm/README.md",
+		Status:  ProposalActivated,
+		Upgrade: DurangoUpgradeName,
+	},
+	{
+		ID:      ACPID(31),
+		Title:   "Enable Chain Ownership Transfer",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/31-enable-chain-ownership-transfer/README.md",
+		Status:  ProposalActivated,
+		Upgrade: DurangoUpgradeName,
+	},
+	{
+		ID:      ACPID(41),
+		Title:   "Remove Pending Stakers",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/41-remove-pending-stakers/README.md",
+		Status:  ProposalActivated,
+		Upgrade: DurangoUpgradeName,
+	},
+	{
+		ID:      ACPID(62),
+		Title:   "Disable AddValidatorTx and AddDelegatorTx",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/62-disable-addvalidatortx-and-adddelegatortx/README.md",
+		Status:  ProposalActivated,
+		Upgrade: DurangoUpgradeName,
+	},
+
+	// Quasar Edition:
+	{
+		ID:      ACPID(77),
+		Title:   "Reinventing Chains",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/77-reinventing-chains/README.md",
+		Status:  ProposalActivated,
+		Upgrade: QuasarUpgradeName,
+	},
+	{
+		ID:      ACPID(103),
+		Title:   "Dynamic Fees",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/103-dynamic-fees/README.md",
+		Status:  ProposalActivated,
+		Upgrade: QuasarUpgradeName,
+	},
+	{
+		ID:      ACPID(118),
+		Title:   "Warp Signature Request",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/118-warp-signature-request/README.md",
+		Status:  ProposalActivated,
+		Upgrade: QuasarUpgradeName,
+	},
+	{
+		ID:      ACPID(125),
+		Title:   "Base Fee Reduction",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/125-basefee-reduction/README.md",
+		Status:  ProposalActivated,
+		Upgrade: QuasarUpgradeName,
+	},
+	{
+		ID:      ACPID(131),
+		Title:   "Cancun EIPs",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/131-cancun-eips/README.md",
+		Status:  ProposalActivated,
+		Upgrade: QuasarUpgradeName,
+		// Mainnet is pre-Shanghai; see ACP-24.
+		Activation: map[NetworkID]time.Time{
+			MainnetID: UnscheduledActivationTime,
+		},
+	},
+	{
+		ID:      ACPID(151),
+		Title:   "Use Current Block P-Chain Height as Context",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/151-use-current-block-pchain-height-as-context/README.md",
+		Status:  ProposalActivated,
+		Upgrade: QuasarUpgradeName,
+	},
+
+	// Next:
+	{
+		ID:      ACPID(176),
+		Title:   "Dynamic EVM Gas Limit and Price Discovery Updates",
+		URL:     "https://github.com/luxfi/ACPs/blob/main/ACPs/176-dynamic-evm-gas-limit-and-price-discovery-updates/README.md",
+		Status:  ProposalImplementable,
+		Upgrade: NextUpgradeName,
+	},
+	{
+		// The Lux port of ACP-176. Dev networks run it from genesis; public
+		// networks get it with the next upgrade.
+		ID:      LPID(176),
+		Title:   "Dynamic EVM Gas Limit and Price Discovery Updates",
+		Status:  ProposalActivated,
+		Upgrade: NextUpgradeName,
+		Activation: map[NetworkID]time.Time{
+			DevnetID:       GenesisActivationTime,
+			LocalNetworkID: GenesisActivationTime,
+		},
+	},
+}
+
+// The sets below are views of Proposals, kept for callers that only need
+// numbers. Gate behavior on FeatureEnabled instead.
 var (
 	// ActivatedACPs is the set of ACPs that are activated.
-	//
-	// See: https://github.com/orgs/luxfi/projects/1
-	ActivatedACPs = set.Of[uint32](
-		// Durango:
-		23, // https://github.com/luxfi/ACPs/blob/main/ACPs/23-p-chain-native-transfers/README.md
-		24, // https://github.com/luxfi/ACPs/blob/main/ACPs/24-shanghai-eips/README.md
-		25, // https://github.com/luxfi/ACPs/blob/main/ACPs/25-vm-application-errors/README.md
-		30, // https://github.com/luxfi/ACPs/blob/main/ACPs/30-lux-warp-x-evm/README.md
-		31, // https://github.com/luxfi/ACPs/blob/main/ACPs/31-enable-chain-ownership-transfer/README.md
-		41, // https://github.com/luxfi/ACPs/blob/main/ACPs/41-remove-pending-stakers/README.md
-		62, // https://github.com/luxfi/ACPs/blob/main/ACPs/62-disable-addvalidatortx-and-adddelegatortx/README.md
-
-		// Quasar Edition:
-		77,  // https://github.com/luxfi/ACPs/blob/main/ACPs/77-reinventing-chains/README.md
-		103, // https://github.com/luxfi/ACPs/blob/main/ACPs/103-dynamic-fees/README.md
-		118, // https://github.com/luxfi/ACPs/blob/main/ACPs/118-warp-signature-request/README.md
-		125, // https://github.com/luxfi/ACPs/blob/main/ACPs/125-basefee-reduction/README.md
-		131, // https://github.com/luxfi/ACPs/blob/main/ACPs/131-cancun-eips/README.md
-		151, // https://github.com/luxfi/ACPs/blob/main/ACPs/151-use-current-block-pchain-height-as-context/README.md
-	)
+	ActivatedACPs = proposalNumbers(ACP, func(p Proposal) bool {
+		return p.Status == ProposalActivated
+	})
 
 	// CurrentACPs is the set of ACPs that are currently, at the time of
 	// release, marked as implementable and not activated.
-	//
-	// See: https://github.com/orgs/luxfi/projects/1
-	CurrentACPs = set.Of[uint32](
-		176, // https://github.com/luxfi/ACPs/blob/main/ACPs/176-dynamic-evm-gas-limit-and-price-discovery-updates/README.md
-	)
+	CurrentACPs = proposalNumbers(ACP, func(p Proposal) bool {
+		return p.Status == ProposalImplementable
+	})
 
 	// ScheduledACPs are the ACPs included into the next upgrade.
-	ScheduledACPs = set.Of[uint32](
-		176, // https://github.com/luxfi/ACPs/blob/main/ACPs/176-dynamic-evm-gas-limit-and-price-discovery-updates/README.md
-	)
+	ScheduledACPs = proposalNumbers(ACP, func(p Proposal) bool {
+		return p.Upgrade == NextUpgradeName
+	})
 
 	// CurrentLPs is the set of supported Lux Protocols
-	CurrentLPs = set.Of[uint32](176)
+	CurrentLPs = proposalNumbers(LP, func(p Proposal) bool {
+		return p.Status != ProposalProposed
+	})
 
 	// ScheduledLPs are the LPs included in the next upgrade
-	ScheduledLPs = set.Of[uint32](176)
+	ScheduledLPs = proposalNumbers(LP, func(p Proposal) bool {
+		return p.Upgrade == NextUpgradeName
+	})
 
 	// ActivatedLPs is the set of activated LPs
-	ActivatedLPs = set.Of[uint32](176)
+	ActivatedLPs = proposalNumbers(LP, func(p Proposal) bool {
+		return p.Status == ProposalActivated
+	})
 )
+
+func proposalNumbers(kind ProposalKind, include func(Proposal) bool) set.Set[uint32] {
+	s := set.Of[uint32]()
+	for _, p := range Proposals {
+		if p.ID.Kind == kind && include(p) {
+			s.Add(p.ID.Number)
+		}
+	}
+	return s
+}
+
+// LookupProposal returns the catalog entry for [id].
+func LookupProposal(id ProposalID) (Proposal, bool) {
+	for _, p := range Proposals {
+		if p.ID == id {
+			return p, true
+		}
+	}
+	return Proposal{}, false
+}
+
+// IsActive reports whether the proposal [id] is active on [networkID] at
+// [t] according to DefaultUpgradeSchedule. Proposals missing from the
+// catalog are never active.
+func IsActive(id ProposalID, networkID NetworkID, t time.Time) bool {
+	return DefaultUpgradeSchedule.IsProposalActive(id, networkID, t)
+}
+
+// ActiveAt returns the proposals active on [networkID] at [t] according to
+// DefaultUpgradeSchedule, in catalog order.
+func ActiveAt(networkID NetworkID, t time.Time) []ProposalID {
+	return DefaultUpgradeSchedule.ActiveProposals(networkID, t)
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/luxfi/math/set"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalViews(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(set.Of[uint32](23, 24, 25, 30, 31, 41, 62, 77, 103, 118, 125, 131, 151), ActivatedACPs)
+	require.Equal(set.Of[uint32](176), CurrentACPs)
+	require.Equal(set.Of[uint32](176), ScheduledACPs)
+	require.Equal(set.Of[uint32](176), CurrentLPs)
+	require.Equal(set.Of[uint32](176), ScheduledLPs)
+	require.Equal(set.Of[uint32](176), ActivatedLPs)
+
+	seen := set.Of[ProposalID]()
+	for _, p := range Proposals {
+		require.False(seen.Contains(p.ID), p.ID)
+		seen.Add(p.ID)
+		require.NotEmpty(p.Title, p.ID)
+		require.NotEmpty(p.Upgrade, p.ID)
+	}
+}
+
+func TestIsActive(t *testing.T) {
+	quasarMainnet := QuasarActivationTime[MainnetID]
+	tests := []struct {
+		name      string
+		id        ProposalID
+		networkID NetworkID
+		time      time.Time
+		expected  bool
+	}{
+		{
+			name:      "before activation",
+			id:        ACPID(77),
+			networkID: MainnetID,
+			time:      quasarMainnet.Add(-time.Second),
+		},
+		{
+			name:      "at activation",
+			id:        ACPID(77),
+			networkID: MainnetID,
+			time:      quasarMainnet,
+			expected:  true,
+		},
+		{
+			name:      "activated proposal on custom network",
+			id:        ACPID(77),
+			networkID: 12345,
+			time:      quasarMainnet.Add(-time.Hour),
+			expected:  true,
+		},
+		{
+			name:      "implementable proposal",
+			id:        ACPID(176),
+			networkID: LocalID,
+			time:      time.Now(),
+		},
+		{
+			name:      "LP with the same number as an ACP",
+			id:        LPID(176),
+			networkID: LocalID,
+			time:      GenesisActivationTime,
+			expected:  true,
+		},
+		{
+			name:      "activated proposal of an unscheduled upgrade",
+			id:        LPID(176),
+			networkID: MainnetID,
+			time:      time.Now(),
+		},
+		{
+			name:      "unknown proposal",
+			id:        LPID(1),
+			networkID: MainnetID,
+			time:      time.Now(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, IsActive(test.id, test.networkID, test.time))
+		})
+	}
+}
+
+func TestActiveAt(t *testing.T) {
+	require := require.New(t)
+
+	quasarTestnet := QuasarActivationTime[TestnetID]
+	require.Equal(
+		[]ProposalID{ACPID(23), ACPID(24), ACPID(25), ACPID(30), ACPID(31), ACPID(41), ACPID(62)},
+		ActiveAt(TestnetID, quasarTestnet.Add(-time.Second)),
+	)
+	require.Equal(
+		[]ProposalID{ACPID(23), ACPID(24), ACPID(25), ACPID(30), ACPID(31), ACPID(41), ACPID(62)},
+		ActiveAt(TestnetID, GenesisActivationTime),
+	)
+	// Mainnet's C-Chain is pre-Shanghai, so ACP-24 and ACP-131 aren't active.
+	require.Len(ActiveAt(MainnetID, QuasarActivationTime[MainnetID]), ActivatedACPs.Len()-2)
+	require.Contains(ActiveAt(LocalID, GenesisActivationTime), LPID(176))
+	require.Equal("ACP-77", ACPID(77).String())
+}
+
+func TestProposalJSON(t *testing.T) {
+	require := require.New(t)
+
+	b, err := json.Marshal(Proposals)
+	require.NoError(err)
+
+	var got []Proposal
+	require.NoError(json.Unmarshal(b, &got))
+	require.Equal(Proposals, got)
+
+	p, ok := LookupProposal(LPID(176))
+	require.True(ok)
+	b, err = json.Marshal(p)
+	require.NoError(err)
+	require.JSONEq(`{
+		"id": "LP-176",
+		"title": "Dynamic EVM Gas Limit and Price Discovery Updates",
+		"status": "activated",
+		"upgrade": "Next",
+		"activation": {
+			"devnet": "1970-01-01T00:00:00Z",
+			"local": "1970-01-01T00:00:00Z"
+		}
+	}`, string(b))
+
+	var status ProposalStatus
+	require.ErrorIs(json.Unmarshal([]byte(`"withdrawn"`), &status), ErrInvalidProposalStatus)
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding"
+	"encoding/json"
+	"flag"
+	"strconv"
+	"strings"
+)
+
+// networkIDFlagType is reported by NetworkID.Type for pflag help output.
+const networkIDFlagType = "network"
+
+var (
+	_ encoding.TextMarshaler   = NetworkID(0)
+	_ encoding.TextUnmarshaler = (*NetworkID)(nil)
+	_ json.Marshaler           = NetworkID(0)
+	_ json.Unmarshaler         = (*NetworkID)(nil)
+	_ flag.Value               = (*NetworkID)(nil)
+)
+
+// String returns the canonical name of the network, as NetworkName does.
+func (n NetworkID) String() string {
+	return NetworkName(n)
+}
+
+// MarshalText writes the canonical network name ("mainnet", "network-42").
+func (n NetworkID) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText accepts a network name ("mainnet"), a bech32 HRP ("lux"),
+// a decimal ID ("1") or the "network-<id>" form ("network-42"). Matching is
+// case-insensitive.
+func (n *NetworkID) UnmarshalText(text []byte) error {
+	s := strings.ToLower(strings.TrimSpace(string(text)))
+	id, err := NetworkIDFromName(s)
+	if err != nil {
+		hrpID, ok := NetworkHRPToNetworkID[s]
+		if !ok {
+			return err
+		}
+		id = hrpID
+	}
+	*n = id
+	return nil
+}
+
+// MarshalJSON writes the canonical network name as a JSON string.
+func (n NetworkID) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(n.String())), nil
+}
+
+// UnmarshalJSON accepts either a JSON string, parsed as UnmarshalText does,
+// or a bare JSON number for configs that write "network-id": 1. As with other
+// non-pointer values, null leaves [n] unchanged.
+func (n *NetworkID) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		s = string(b)
+	}
+	return n.UnmarshalText([]byte(s))
+}
+
+// Set implements flag.Value and the spf13/pflag Value interface.
+func (n *NetworkID) Set(s string) error {
+	return n.UnmarshalText([]byte(s))
+}
+
+// Type implements the spf13/pflag Value interface.
+func (*NetworkID) Type() string {
+	return networkIDFlagType
+}
@@ -13,16 +13,26 @@ import (
 	"github.com/luxfi/math/set"
 )
 
+// NetworkID identifies a primary network on the P-Chain (1, 2, 3, 1337, ...).
+// It is deliberately distinct from EVMChainID so that passing a C-Chain
+// chain ID where a network ID is expected is a compile error.
+type NetworkID uint32
+
+// EVMChainID is the EIP-155 chain ID of an EVM chain (96369, 96368, ...).
+// Use NetworkIDForEVMChain and EVMChainIDForNetwork to convert between the
+// two keyspaces.
+type EVMChainID uint32
+
 // Const variables to be exported
 const (
 	// Network IDs (P-Chain) - these identify the PRIMARY NETWORK
 	// mainnet, testnet, devnet: proper public networks (can run locally with validators)
 	// Network IDs (P-Chain) — identify the primary network
-	MainnetID  uint32 = 1    // Production
-	TestnetID  uint32 = 2    // Staging
-	DevnetID   uint32 = 3    // Development
-	LocalID    uint32 = 1337 // Local single/multi-node dev
-	UnitTestID uint32 = 369
+	MainnetID  NetworkID = 1    // Production
+	TestnetID  NetworkID = 2    // Staging
+	DevnetID   NetworkID = 3    // Development
+	LocalID    NetworkID = 1337 // Local single/multi-node dev
+	UnitTestID NetworkID = 369
 
 	// Aliases
 	LuxMainnetID = MainnetID
@@ -30,13 +40,13 @@ const (
 
 	// CustomID means any network ID not in {1, 2, 3, 1337}.
 	// Requires --genesis-file to provide configuration.
-	CustomID uint32 = 0
+	CustomID NetworkID = 0
 
 	// Chain IDs (C-Chain EVM) — for wallets/dApps
-	MainnetChainID uint32 = 96369
-	TestnetChainID uint32 = 96368
-	DevnetChainID  uint32 = 96370
-	LocalChainID   uint32 = 31337 // EVM chain ID for localnet (Anvil convention)
+	MainnetChainID EVMChainID = 96369
+	TestnetChainID EVMChainID = 96368
+	DevnetChainID  EVMChainID = 96370
+	LocalChainID   EVMChainID = 31337 // EVM chain ID for localnet (Anvil convention)
 
 	// Q-Chain shares the primary network ID (1/2/3/1337).
 	// No separate network IDs — Q-Chain is a primary-network chain like P, X, C.
@@ -85,20 +95,17 @@ var (
 	// gets the name "custom" — addresses on such a network look like
 	// `X-custom1...`, `P-custom1...`. Any unknown ID also falls back to
 	// CustomName via NetworkName().
-	NetworkIDToNetworkName = map[uint32]string{
-		MainnetID:      MainnetName, // 1
-		TestnetID:      TestnetName, // 2
-		DevnetID:       DevnetName,  // 3
-		LocalID:        LocalName,   // 1337
-		CustomID:       CustomName,  // 0 — user-defined sentinel
-		UnitTestID:     UnitTestName,
-		MainnetChainID: MainnetName,
-		TestnetChainID: TestnetName,
-		DevnetChainID:  DevnetName,
+	NetworkIDToNetworkName = map[NetworkID]string{
+		MainnetID:  MainnetName, // 1
+		TestnetID:  TestnetName, // 2
+		DevnetID:   DevnetName,  // 3
+		LocalID:    LocalName,   // 1337
+		CustomID:   CustomName,  // 0 — user-defined sentinel
+		UnitTestID: UnitTestName,
 	}
 
 	// NetworkNameToNetworkID maps names to network IDs.
-	NetworkNameToNetworkID = map[string]uint32{
+	NetworkNameToNetworkID = map[string]NetworkID{
 		MainnetName:  MainnetID,
 		TestnetName:  TestnetID,
 		DevnetName:   DevnetID,
@@ -110,16 +117,13 @@ var (
 	// NetworkIDToHRP maps network IDs to bech32 address prefix.
 	// 1 → P-lux1..., 2 → P-test1..., 3 → P-dev1..., 1337 → P-local1...,
 	// 0 (or any unknown ID) → P-custom1... via GetHRP fallback.
-	NetworkIDToHRP = map[uint32]string{
-		MainnetID:      MainnetHRP, // lux
-		TestnetID:      TestnetHRP, // test
-		DevnetID:       DevnetHRP,  // dev
-		LocalID:        LocalHRP,   // local
-		CustomID:       CustomHRP,  // custom
-		UnitTestID:     UnitTestHRP,
-		MainnetChainID: MainnetHRP,
-		TestnetChainID: TestnetHRP,
-		DevnetChainID:  DevnetHRP,
+	NetworkIDToHRP = map[NetworkID]string{
+		MainnetID:  MainnetHRP, // lux
+		TestnetID:  TestnetHRP, // test
+		DevnetID:   DevnetHRP,  // dev
+		LocalID:    LocalHRP,   // local
+		CustomID:   CustomHRP,  // custom
+		UnitTestID: UnitTestHRP,
 	}
 
 	// NetworkHRPToNetworkID maps HRP back to network ID.
@@ -128,7 +132,7 @@ var (
 	// reverse-mapping any "custom"-prefixed address back to a numeric
 	// ID requires the network ID to be specified out-of-band (genesis
 	// file, RPC parameter, etc.) since the HRP itself is not unique.
-	NetworkHRPToNetworkID = map[string]uint32{
+	NetworkHRPToNetworkID = map[string]NetworkID{
 		MainnetHRP:  MainnetID,
 		TestnetHRP:  TestnetID,
 		DevnetHRP:   DevnetID,
@@ -137,8 +141,29 @@ var (
 		UnitTestHRP: UnitTestID,
 	}
 
+	// NetworkIDToEVMChainID maps a primary network to the EIP-155 chain ID
+	// of its C-Chain. Custom networks have no well-known C-Chain ID.
+	NetworkIDToEVMChainID = map[NetworkID]EVMChainID{
+		MainnetID: MainnetChainID, // 1 → 96369
+		TestnetID: TestnetChainID, // 2 → 96368
+		DevnetID:  DevnetChainID,  // 3 → 96370
+		LocalID:   LocalChainID,   // 1337 → 31337
+	}
+
+	// EVMChainIDToNetworkID maps a C-Chain EIP-155 chain ID back to the
+	// primary network it belongs to.
+	EVMChainIDToNetworkID = map[EVMChainID]NetworkID{
+		MainnetChainID: MainnetID,
+		TestnetChainID: TestnetID,
+		DevnetChainID:  DevnetID,
+		LocalChainID:   LocalID,
+	}
+
 	// ProductionNetworkIDs are networks that should use production-grade settings
-	ProductionNetworkIDs = set.Of(MainnetID, TestnetID, MainnetChainID, TestnetChainID)
+	ProductionNetworkIDs = set.Of(MainnetID, TestnetID)
+
+	// ProductionEVMChainIDs are the C-Chain IDs of ProductionNetworkIDs.
+	ProductionEVMChainIDs = set.Of(MainnetChainID, TestnetChainID)
 
 	ValidNetworkPrefix = "network-"
 
@@ -147,16 +172,29 @@ var (
 	ErrUnknownChain     = errors.New("unknown chain name")
 )
 
+// EVMChainIDForNetwork returns the C-Chain EIP-155 chain ID of the primary
+// network [networkID]. The bool is false for networks without a well-known
+// C-Chain ID.
+func EVMChainIDForNetwork(networkID NetworkID) (EVMChainID, bool) {
+	chainID, ok := NetworkIDToEVMChainID[networkID]
+	return chainID, ok
+}
+
+// NetworkIDForEVMChain returns the primary network whose C-Chain uses the
+// EIP-155 chain ID [chainID]. The bool is false for unknown chain IDs.
+func NetworkIDForEVMChain(chainID EVMChainID) (NetworkID, bool) {
+	networkID, ok := EVMChainIDToNetworkID[chainID]
+	return networkID, ok
+}
+
 // IsCustom reports whether the networkID falls outside the well-known
-// {Mainnet, Testnet, Devnet, Local, UnitTest, Mainnet/Testnet/Devnet
-// chainID} set — i.e. it is a user-defined "custom" primary network
-// (e.g. a private testnet on ID 42, or the explicit CustomID sentinel
-// of 0). Custom networks use the "custom" HRP, so addresses on them
-// look like P-custom1..., X-custom1...
-func IsCustom(networkID uint32) bool {
+// {Mainnet, Testnet, Devnet, Local, UnitTest} set — i.e. it is a
+// user-defined "custom" primary network (e.g. a private testnet on ID 42,
+// or the explicit CustomID sentinel of 0). Custom networks use the
+// "custom" HRP, so addresses on them look like P-custom1..., X-custom1...
+func IsCustom(networkID NetworkID) bool {
 	switch networkID {
-	case MainnetID, TestnetID, DevnetID, LocalID, UnitTestID,
-		MainnetChainID, TestnetChainID, DevnetChainID:
+	case MainnetID, TestnetID, DevnetID, LocalID, UnitTestID:
 		return false
 	}
 	return true
@@ -166,7 +204,7 @@ func IsCustom(networkID uint32) bool {
 // networkID. Falls back to CustomHRP for any non-well-known ID, so
 // users running a private network on, say, ID 42 get P-custom1...
 // addresses without having to register their ID anywhere.
-func GetHRP(networkID uint32) string {
+func GetHRP(networkID NetworkID) string {
 	if hrp, ok := NetworkIDToHRP[networkID]; ok {
 		return hrp
 	}
@@ -178,7 +216,7 @@ func GetHRP(networkID uint32) string {
 // ("mainnet", "testnet", "devnet", "local", "custom"). Any other
 // non-well-known ID returns "network-<id>" so two distinct user
 // networks on different IDs remain distinguishable in logs.
-func NetworkName(networkID uint32) string {
+func NetworkName(networkID NetworkID) string {
 	if name, exists := NetworkIDToNetworkName[networkID]; exists {
 		return name
 	}
@@ -191,8 +229,8 @@ func NetworkName(networkID uint32) string {
 	return fmt.Sprintf("network-%d", networkID)
 }
 
-// NetworkID returns the ID of the network with name [networkName]
-func NetworkID(networkName string) (uint32, error) {
+// NetworkIDFromName returns the ID of the network with name [networkName]
+func NetworkIDFromName(networkName string) (NetworkID, error) {
 	networkName = strings.ToLower(networkName)
 	if id, exists := NetworkNameToNetworkID[networkName]; exists {
 		return id, nil
@@ -206,5 +244,5 @@ func NetworkID(networkName string) (uint32, error) {
 	if err != nil {
 		return 0, fmt.Errorf("%w: %q", ErrParseNetworkName, networkName)
 	}
-	return uint32(id), nil
+	return NetworkID(id), nil
 }
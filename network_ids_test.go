@@ -4,6 +4,8 @@
 package constants
 
 import (
+	"encoding/json"
+	"flag"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -11,7 +13,7 @@ import (
 
 func TestGetHRP(t *testing.T) {
 	tests := []struct {
-		id  uint32
+		id  NetworkID
 		hrp string
 	}{
 		{
@@ -44,7 +46,7 @@ func TestGetHRP(t *testing.T) {
 
 func TestNetworkName(t *testing.T) {
 	tests := []struct {
-		id   uint32
+		id   NetworkID
 		name string
 	}{
 		{
@@ -75,10 +77,10 @@ func TestNetworkName(t *testing.T) {
 	}
 }
 
-func TestNetworkID(t *testing.T) {
+func TestNetworkIDFromName(t *testing.T) {
 	tests := []struct {
 		name        string
-		id          uint32
+		id          NetworkID
 		expectedErr error
 	}{
 		{
@@ -126,9 +128,127 @@ func TestNetworkID(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			require := require.New(t)
 
-			id, err := NetworkID(test.name)
+			id, err := NetworkIDFromName(test.name)
 			require.ErrorIs(err, test.expectedErr)
 			require.Equal(test.id, id)
 		})
 	}
 }
+
+func TestEVMChainIDConversion(t *testing.T) {
+	tests := []struct {
+		networkID NetworkID
+		chainID   EVMChainID
+	}{
+		{
+			networkID: MainnetID,
+			chainID:   MainnetChainID,
+		},
+		{
+			networkID: TestnetID,
+			chainID:   TestnetChainID,
+		},
+		{
+			networkID: DevnetID,
+			chainID:   DevnetChainID,
+		},
+		{
+			networkID: LocalID,
+			chainID:   LocalChainID,
+		},
+	}
+	for _, test := range tests {
+		t.Run(NetworkName(test.networkID), func(t *testing.T) {
+			require := require.New(t)
+
+			chainID, ok := EVMChainIDForNetwork(test.networkID)
+			require.True(ok)
+			require.Equal(test.chainID, chainID)
+
+			networkID, ok := NetworkIDForEVMChain(test.chainID)
+			require.True(ok)
+			require.Equal(test.networkID, networkID)
+		})
+	}
+
+	_, ok := EVMChainIDForNetwork(42)
+	require.False(t, ok)
+	_, ok = NetworkIDForEVMChain(42)
+	require.False(t, ok)
+}
+
+func TestNetworkIDUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text        string
+		id          NetworkID
+		expectedErr error
+	}{
+		{
+			text: MainnetName,
+			id:   MainnetID,
+		},
+		{
+			text: "1",
+			id:   MainnetID,
+		},
+		{
+			text: "network-42",
+			id:   42,
+		},
+		{
+			text: MainnetHRP,
+			id:   MainnetID,
+		},
+		{
+			text: "TEST",
+			id:   TestnetID,
+		},
+		{
+			text:        "moonnet",
+			expectedErr: ErrParseNetworkName,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			require := require.New(t)
+
+			var id NetworkID
+			err := id.UnmarshalText([]byte(test.text))
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.id, id)
+		})
+	}
+}
+
+func TestNetworkIDJSON(t *testing.T) {
+	require := require.New(t)
+
+	type config struct {
+		NetworkID NetworkID `json:"network-id"`
+	}
+
+	b, err := json.Marshal(config{NetworkID: 42})
+	require.NoError(err)
+	require.JSONEq(`{"network-id":"network-42"}`, string(b))
+
+	var c config
+	require.NoError(json.Unmarshal([]byte(`{"network-id":"lux"}`), &c))
+	require.Equal(MainnetID, c.NetworkID)
+
+	require.NoError(json.Unmarshal([]byte(`{"network-id":2}`), &c))
+	require.Equal(TestnetID, c.NetworkID)
+
+	require.NoError(json.Unmarshal([]byte(`{"network-id":null}`), &c))
+	require.Equal(TestnetID, c.NetworkID)
+}
+
+func TestNetworkIDFlag(t *testing.T) {
+	require := require.New(t)
+
+	var id NetworkID
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&id, "network-id", "network to connect to")
+	require.NoError(fs.Parse([]string{"--network-id=devnet"}))
+	require.Equal(DevnetID, id)
+	require.Equal(DevnetName, id.String())
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NetworkAliasPrefix prefixes network names in the "lux-mainnet" alias form.
+const NetworkAliasPrefix = PlatformName + "-"
+
+var ErrAmbiguousNetwork = errors.New("ambiguous network identifier")
+
+// NetworkIdentifierKind describes which kind of identifier ParseNetwork
+// matched.
+type NetworkIdentifierKind byte
+
+const (
+	NetworkIdentifierName       NetworkIdentifierKind = iota + 1 // "mainnet"
+	NetworkIdentifierAlias                                       // "lux-mainnet"
+	NetworkIdentifierHRP                                         // "lux"
+	NetworkIdentifierNetworkID                                   // "1", "network-42"
+	NetworkIdentifierEVMChainID                                  // "96369", "0x17871"
+)
+
+func (k NetworkIdentifierKind) String() string {
+	switch k {
+	case NetworkIdentifierName:
+		return "name"
+	case NetworkIdentifierAlias:
+		return "alias"
+	case NetworkIdentifierHRP:
+		return "hrp"
+	case NetworkIdentifierNetworkID:
+		return "network ID"
+	case NetworkIdentifierEVMChainID:
+		return "EVM chain ID"
+	default:
+		return "unknown"
+	}
+}
+
+// ParsedNetwork is the result of ParseNetwork.
+type ParsedNetwork struct {
+	// Input is the identifier as given by the caller.
+	Input string
+	// Kind is the kind of identifier that matched.
+	Kind NetworkIdentifierKind
+	// NetworkID is the primary network the identifier resolved to.
+	NetworkID NetworkID
+	// EVMChainID is the C-Chain ID of NetworkID, or 0 if it has none.
+	EVMChainID EVMChainID
+}
+
+// ParseNetwork resolves a user-supplied network identifier. In addition to
+// what NetworkIDFromName accepts, it understands bech32 HRPs ("lux"),
+// "lux-mainnet" style aliases, C-Chain EVM chain IDs ("96369") and
+// hexadecimal numbers ("0x17871").
+//
+// A decimal or hex number is read both as a network ID and, if it is one, as
+// a well-known EVM chain ID. If the input matches identifiers of more than
+// one network, e.g. "96369" is custom network 96369 and mainnet's EVM chain,
+// ErrAmbiguousNetwork is returned rather than guessing; "network-<id>" or the
+// network's name picks one.
+func ParseNetwork(input string) (ParsedNetwork, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+
+	var matches []ParsedNetwork
+	add := func(kind NetworkIdentifierKind, networkID NetworkID) {
+		chainID, _ := EVMChainIDForNetwork(networkID)
+		matches = append(matches, ParsedNetwork{
+			Input:      input,
+			Kind:       kind,
+			NetworkID:  networkID,
+			EVMChainID: chainID,
+		})
+	}
+
+	if id, ok := NetworkNameToNetworkID[s]; ok {
+		add(NetworkIdentifierName, id)
+	}
+	if name, ok := strings.CutPrefix(s, NetworkAliasPrefix); ok {
+		if id, ok := NetworkNameToNetworkID[name]; ok {
+			add(NetworkIdentifierAlias, id)
+		}
+	}
+	if id, ok := NetworkHRPToNetworkID[s]; ok {
+		add(NetworkIdentifierHRP, id)
+	}
+	if idStr, ok := strings.CutPrefix(s, ValidNetworkPrefix); ok {
+		// "network-<id>" is explicit about its keyspace.
+		id, err := strconv.ParseUint(idStr, 10, 32)
+		if err != nil {
+			return ParsedNetwork{}, fmt.Errorf("%w: %q", ErrParseNetworkName, input)
+		}
+		add(NetworkIdentifierNetworkID, NetworkID(id))
+	}
+	if n, ok := parseNetworkNumber(s); ok {
+		add(NetworkIdentifierNetworkID, NetworkID(n))
+		if id, ok := NetworkIDForEVMChain(EVMChainID(n)); ok {
+			add(NetworkIdentifierEVMChainID, id)
+		}
+	}
+
+	switch {
+	case len(matches) == 0:
+		return ParsedNetwork{}, fmt.Errorf("%w: %q", ErrParseNetworkName, input)
+	case !sameNetwork(matches):
+		candidates := make([]string, len(matches))
+		for i, m := range matches {
+			candidates[i] = fmt.Sprintf("%s (%s)", m.Kind, m.NetworkID)
+		}
+		return ParsedNetwork{}, fmt.Errorf("%w: %q could be %s",
+			ErrAmbiguousNetwork, input, strings.Join(candidates, " or "))
+	default:
+		return matches[0], nil
+	}
+}
+
+// parseNetworkNumber parses a decimal or 0x-prefixed hexadecimal uint32.
+func parseNetworkNumber(s string) (uint32, bool) {
+	base := 10
+	if hex, ok := strings.CutPrefix(s, "0x"); ok {
+		s, base = hex, 16
+	}
+	n, err := strconv.ParseUint(s, base, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(n), true
+}
+
+func sameNetwork(matches []ParsedNetwork) bool {
+	for _, m := range matches[1:] {
+		if m.NetworkID != matches[0].NetworkID {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNetwork(t *testing.T) {
+	tests := []struct {
+		input       string
+		kind        NetworkIdentifierKind
+		networkID   NetworkID
+		chainID     EVMChainID
+		expectedErr error
+	}{
+		{
+			input:     "Mainnet",
+			kind:      NetworkIdentifierName,
+			networkID: MainnetID,
+			chainID:   MainnetChainID,
+		},
+		{
+			input:     "lux-testnet",
+			kind:      NetworkIdentifierAlias,
+			networkID: TestnetID,
+			chainID:   TestnetChainID,
+		},
+		{
+			input:     DevnetHRP,
+			kind:      NetworkIdentifierHRP,
+			networkID: DevnetID,
+			chainID:   DevnetChainID,
+		},
+		{
+			input:     "1337",
+			kind:      NetworkIdentifierNetworkID,
+			networkID: LocalID,
+			chainID:   LocalChainID,
+		},
+		{
+			input:       "96369",
+			expectedErr: ErrAmbiguousNetwork,
+		},
+		{
+			input:       "0x17871",
+			expectedErr: ErrAmbiguousNetwork,
+		},
+		{
+			input:     "network-96369",
+			kind:      NetworkIdentifierNetworkID,
+			networkID: 96369,
+		},
+		{
+			input:     "42",
+			kind:      NetworkIdentifierNetworkID,
+			networkID: 42,
+		},
+		{
+			input:       "lux-moonnet",
+			expectedErr: ErrParseNetworkName,
+		},
+		{
+			input:       "network-x",
+			expectedErr: ErrParseNetworkName,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			require := require.New(t)
+
+			parsed, err := ParseNetwork(test.input)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(ParsedNetwork{
+				Input:      test.input,
+				Kind:       test.kind,
+				NetworkID:  test.networkID,
+				EVMChainID: test.chainID,
+			}, parsed)
+		})
+	}
+}
+
+func TestParseNetworkAmbiguous(t *testing.T) {
+	require := require.New(t)
+
+	// EVM chain ID 2 pointing at devnet collides with network ID 2 (testnet).
+	EVMChainIDToNetworkID[2] = DevnetID
+	t.Cleanup(func() { delete(EVMChainIDToNetworkID, 2) })
+
+	_, err := ParseNetwork("2")
+	require.ErrorIs(err, ErrAmbiguousNetwork)
+
+	parsed, err := ParseNetwork("network-2")
+	require.NoError(err)
+	require.Equal(TestnetID, parsed.NetworkID)
+
+	// Identifiers of the same network aren't ambiguous.
+	EVMChainIDToNetworkID[2] = TestnetID
+	parsed, err = ParseNetwork("2")
+	require.NoError(err)
+	require.Equal(TestnetID, parsed.NetworkID)
+}
+
+// TestNetworkKeyspacesDisjoint checks that no well-known EVM chain ID is also
+// a well-known network ID, so ParseNetwork only reports ambiguity against
+// custom networks.
+func TestNetworkKeyspacesDisjoint(t *testing.T) {
+	for chainID := range EVMChainIDToNetworkID {
+		require.True(t, IsCustom(NetworkID(chainID)), "EVM chain ID %d is also a network ID", chainID)
+	}
+}
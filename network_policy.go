@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+// NetworkClass groups networks that share a security posture.
+type NetworkClass byte
+
+const (
+	NetworkClassProduction NetworkClass = iota + 1 // mainnet
+	NetworkClassStaging                            // testnet
+	NetworkClassDev                                // devnet
+	NetworkClassLocal                              // local and unit-test networks
+	NetworkClassCustom                             // any user-defined network
+)
+
+func (c NetworkClass) String() string {
+	switch c {
+	case NetworkClassProduction:
+		return "production"
+	case NetworkClassStaging:
+		return "staging"
+	case NetworkClassDev:
+		return "dev"
+	case NetworkClassLocal:
+		return "local"
+	case NetworkClassCustom:
+		return "custom"
+	default:
+		return "unknown"
+	}
+}
+
+// IsProductionGrade reports whether networks of this class must run with
+// production-grade settings, i.e. whether they are ProductionNetworkIDs.
+func (c NetworkClass) IsProductionGrade() bool {
+	return c == NetworkClassProduction || c == NetworkClassStaging
+}
+
+// NetworkPolicy is the set of security defaults that the node and the CLI
+// both enforce for a class of networks.
+type NetworkPolicy struct {
+	Class NetworkClass
+
+	// RequireValidatorToConnect restricts peering to connections where at
+	// least one side is a validator.
+	RequireValidatorToConnect bool
+	// HTTPAccess is the default exposure of the HTTP API.
+	HTTPAccess HTTPAccess
+	// AllowUnsafeAPIs permits admin, keystore and other APIs that must never
+	// be reachable on a public network.
+	AllowUnsafeAPIs bool
+	// MinPeers is the minimum number of connected peers for the node to
+	// report healthy.
+	MinPeers int
+}
+
+// NetworkPolicies holds the policy of every network class.
+var NetworkPolicies = map[NetworkClass]NetworkPolicy{
+	NetworkClassProduction: {
+		Class:                     NetworkClassProduction,
+		RequireValidatorToConnect: DefaultNetworkRequireValidatorToConnect,
+		HTTPAccess:                HTTPAccessPrivate,
+		AllowUnsafeAPIs:           false,
+		MinPeers:                  5,
+	},
+	NetworkClassStaging: {
+		Class:                     NetworkClassStaging,
+		RequireValidatorToConnect: DefaultNetworkRequireValidatorToConnect,
+		HTTPAccess:                HTTPAccessPrivate,
+		AllowUnsafeAPIs:           false,
+		MinPeers:                  3,
+	},
+	NetworkClassDev: {
+		Class:                     NetworkClassDev,
+		RequireValidatorToConnect: DefaultNetworkRequireValidatorToConnect,
+		HTTPAccess:                HTTPAccessPublic,
+		AllowUnsafeAPIs:           false,
+		MinPeers:                  DefaultNetworkHealthMinPeers,
+	},
+	NetworkClassLocal: {
+		Class:                     NetworkClassLocal,
+		RequireValidatorToConnect: false,
+		HTTPAccess:                HTTPAccessPublic,
+		AllowUnsafeAPIs:           true,
+		MinPeers:                  0, // single-node dev must report healthy
+	},
+	NetworkClassCustom: {
+		Class:                     NetworkClassCustom,
+		RequireValidatorToConnect: true, // private networks keep a closed topology
+		HTTPAccess:                HTTPAccessPrivate,
+		AllowUnsafeAPIs:           false,
+		MinPeers:                  DefaultNetworkHealthMinPeers,
+	},
+}
+
+// NetworkClassFor returns the class of [networkID]. Membership in
+// ProductionNetworkIDs takes precedence, so adding an ID to that set is
+// enough to give it production-grade settings.
+func NetworkClassFor(networkID NetworkID) NetworkClass {
+	if ProductionNetworkIDs.Contains(networkID) {
+		if networkID == TestnetID {
+			return NetworkClassStaging
+		}
+		return NetworkClassProduction
+	}
+	switch networkID {
+	case DevnetID:
+		return NetworkClassDev
+	case LocalID, UnitTestID:
+		return NetworkClassLocal
+	default:
+		return NetworkClassCustom
+	}
+}
+
+// PolicyFor returns the security policy for [networkID]. If its class has
+// no entry in NetworkPolicies, the custom-network policy applies, since it
+// is the most restrictive one that still lets a node start.
+func PolicyFor(networkID NetworkID) NetworkPolicy {
+	if policy, ok := NetworkPolicies[NetworkClassFor(networkID)]; ok {
+		return policy
+	}
+	return NetworkPolicies[NetworkClassCustom]
+}
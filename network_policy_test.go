@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkClassFor(t *testing.T) {
+	tests := []struct {
+		networkID NetworkID
+		class     NetworkClass
+	}{
+		{networkID: MainnetID, class: NetworkClassProduction},
+		{networkID: TestnetID, class: NetworkClassStaging},
+		{networkID: DevnetID, class: NetworkClassDev},
+		{networkID: LocalID, class: NetworkClassLocal},
+		{networkID: UnitTestID, class: NetworkClassLocal},
+		{networkID: CustomID, class: NetworkClassCustom},
+		{networkID: 12345, class: NetworkClassCustom},
+	}
+	for _, test := range tests {
+		t.Run(test.networkID.String(), func(t *testing.T) {
+			require := require.New(t)
+
+			require.Equal(test.class, NetworkClassFor(test.networkID))
+
+			policy := PolicyFor(test.networkID)
+			require.Equal(test.class, policy.Class)
+			require.Equal(NetworkPolicies[test.class], policy)
+		})
+	}
+}
+
+func TestNetworkClassIsProductionGrade(t *testing.T) {
+	tests := []struct {
+		class    NetworkClass
+		expected bool
+	}{
+		{class: NetworkClassProduction, expected: true},
+		{class: NetworkClassStaging, expected: true},
+		{class: NetworkClassDev},
+		{class: NetworkClassLocal},
+		{class: NetworkClassCustom},
+		{class: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.class.String(), func(t *testing.T) {
+			require.Equal(t, test.expected, test.class.IsProductionGrade())
+		})
+	}
+
+	for networkID := range ProductionNetworkIDs {
+		require.True(t, NetworkClassFor(networkID).IsProductionGrade(), networkID)
+	}
+}
+
+func TestPolicyForFallback(t *testing.T) {
+	require := require.New(t)
+
+	local := NetworkPolicies[NetworkClassLocal]
+	delete(NetworkPolicies, NetworkClassLocal)
+	t.Cleanup(func() {
+		NetworkPolicies[NetworkClassLocal] = local
+	})
+
+	policy := PolicyFor(LocalID)
+	require.Equal(NetworkPolicies[NetworkClassCustom], policy)
+	require.False(policy.AllowUnsafeAPIs)
+}
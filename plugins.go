@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/luxfi/ids"
+)
+
+// PluginInfo describes a single entry found by ScanPlugins.
+type PluginInfo struct {
+	// Path is the path of the plugin binary or symlink.
+	Path string
+	// Current reports whether the entry is under CurrentPluginDir.
+	Current bool
+
+	// VMID is the VM the filename resolves to, or ids.Empty if the
+	// filename is neither a CB58 VM ID nor a registered VM name or alias.
+	VMID ids.ID
+	// VMName is the human readable name of VMID, as returned by VMName.
+	VMName string
+	// Known reports whether VMID is registered in the VM registry.
+	Known bool
+
+	// Executable reports whether the (resolved) file is executable.
+	Executable bool
+	// Duplicate reports whether another entry in the same directory
+	// resolves to the same VM.
+	Duplicate bool
+	// BrokenSymlink reports whether the entry is a symlink whose target
+	// does not exist.
+	BrokenSymlink bool
+	// Err is the error, other than a broken symlink, hit while inspecting
+	// the entry, e.g. a permission error or a symlink loop.
+	Err error
+}
+
+// OK reports whether the plugin is a known, unique, runnable VM.
+func (p PluginInfo) OK() bool {
+	return p.Known && p.Executable && !p.Duplicate && !p.BrokenSymlink && p.Err == nil
+}
+
+// PluginReport is the result of ScanPlugins.
+type PluginReport struct {
+	Plugins []PluginInfo
+}
+
+// Unknown returns plugins whose filename doesn't resolve to a registered VM.
+func (r *PluginReport) Unknown() []PluginInfo {
+	return r.filter(func(p PluginInfo) bool { return !p.Known })
+}
+
+// Duplicates returns plugins that share a VM with another plugin in the same
+// directory.
+func (r *PluginReport) Duplicates() []PluginInfo {
+	return r.filter(func(p PluginInfo) bool { return p.Duplicate })
+}
+
+// NonExecutable returns plugins that exist but aren't executable.
+func (r *PluginReport) NonExecutable() []PluginInfo {
+	return r.filter(func(p PluginInfo) bool { return !p.Executable && !p.BrokenSymlink && p.Err == nil })
+}
+
+// BrokenSymlinks returns plugins that are dangling symlinks.
+func (r *PluginReport) BrokenSymlinks() []PluginInfo {
+	return r.filter(func(p PluginInfo) bool { return p.BrokenSymlink })
+}
+
+// Errored returns plugins that couldn't be inspected.
+func (r *PluginReport) Errored() []PluginInfo {
+	return r.filter(func(p PluginInfo) bool { return p.Err != nil })
+}
+
+func (r *PluginReport) filter(keep func(PluginInfo) bool) []PluginInfo {
+	var plugins []PluginInfo
+	for _, p := range r.Plugins {
+		if keep(p) {
+			plugins = append(plugins, p)
+		}
+	}
+	return plugins
+}
+
+// ScanPlugins lists the VM plugins installed in [pluginDir] (typically
+// ~/.lux/plugins) and in its CurrentPluginDir subdirectory. Plugin filenames
+// are either CB58 VM IDs or VM names/aliases registered in [r]. Hidden files
+// and subdirectories other than CurrentPluginDir are ignored. A missing
+// CurrentPluginDir is not an error, and an entry that can't be inspected is
+// reported with PluginInfo.Err rather than failing the scan.
+func (r *VMRegistry) ScanPlugins(pluginDir string) (*PluginReport, error) {
+	report := &PluginReport{}
+	if err := r.scanPluginDir(report, pluginDir, false); err != nil {
+		return nil, err
+	}
+
+	currentDir := filepath.Join(pluginDir, CurrentPluginDir)
+	switch _, err := os.Stat(currentDir); {
+	case err == nil:
+		if err := r.scanPluginDir(report, currentDir, true); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, fs.ErrNotExist):
+		if _, lerr := os.Lstat(currentDir); lerr == nil {
+			// CurrentPluginDir itself is a dangling symlink.
+			report.Plugins = append(report.Plugins, PluginInfo{
+				Path:          currentDir,
+				Current:       true,
+				BrokenSymlink: true,
+			})
+		}
+	default:
+		return nil, err
+	}
+	return report, nil
+}
+
+func (r *VMRegistry) scanPluginDir(report *PluginReport, dir string, current bool) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	byVM := make(map[ids.ID]int) // VM ID -> index into report.Plugins
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") || (!current && name == CurrentPluginDir) {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		info := PluginInfo{
+			Path:    path,
+			Current: current,
+		}
+
+		stat, err := os.Stat(path)
+		switch {
+		case err == nil:
+			if stat.IsDir() {
+				continue
+			}
+			info.Executable = stat.Mode().Perm()&0o111 != 0
+		case errors.Is(err, fs.ErrNotExist) && entry.Type()&fs.ModeSymlink != 0:
+			info.BrokenSymlink = true
+		default:
+			info.Err = err
+		}
+
+		if vmID, ok := r.pluginVMID(name); ok {
+			info.VMID = vmID
+			info.VMName, info.Known = r.VMName(vmID)
+			if !info.Known {
+				info.VMName = VMName(vmID)
+			}
+
+			if i, ok := byVM[vmID]; ok {
+				report.Plugins[i].Duplicate = true
+				info.Duplicate = true
+			} else {
+				byVM[vmID] = len(report.Plugins)
+			}
+		}
+		report.Plugins = append(report.Plugins, info)
+	}
+	return nil
+}
+
+// pluginVMID resolves a plugin filename, a registered name or alias or else
+// a CB58 VM ID, to a VM ID.
+func (r *VMRegistry) pluginVMID(filename string) (ids.ID, bool) {
+	if vmID, ok := r.VMIDFromName(filename); ok {
+		return vmID, true
+	}
+	vmID, err := ids.FromString(filename)
+	return vmID, err == nil
+}
+
+// ScanPlugins scans [pluginDir] against the DefaultVMRegistry.
+func ScanPlugins(pluginDir string) (*PluginReport, error) {
+	return DefaultVMRegistry.ScanPlugins(pluginDir)
+}
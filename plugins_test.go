@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanPlugins(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	writePlugin := func(name string, perm os.FileMode) string {
+		path := filepath.Join(dir, name)
+		require.NoError(os.WriteFile(path, nil, perm))
+		return path
+	}
+	evmPath := writePlugin(EVMName, 0o755)
+	evmIDPath := writePlugin(EVMID.String(), 0o755) // same VM as "evm"
+	xvmPath := writePlugin(XVMName, 0o644)          // not executable
+	unknownPath := writePlugin("mystery", 0o755)
+
+	current := filepath.Join(dir, CurrentPluginDir)
+	require.NoError(os.Mkdir(current, 0o755))
+	currentEVMPath := filepath.Join(current, EVMName)
+	require.NoError(os.Symlink(evmPath, currentEVMPath))
+	brokenPath := filepath.Join(current, ZKVMName)
+	require.NoError(os.Symlink(filepath.Join(dir, "missing"), brokenPath))
+
+	// A symlink loop fails os.Stat with ELOOP; it must not hide the rest.
+	loopPath := filepath.Join(dir, GraphVMName)
+	require.NoError(os.Symlink(loopPath, loopPath))
+
+	report, err := ScanPlugins(dir)
+	require.NoError(err)
+	require.Len(report.Plugins, 7)
+
+	paths := func(plugins []PluginInfo) []string {
+		var ps []string
+		for _, p := range plugins {
+			ps = append(ps, p.Path)
+		}
+		return ps
+	}
+	require.ElementsMatch([]string{unknownPath}, paths(report.Unknown()))
+	require.ElementsMatch([]string{evmPath, evmIDPath}, paths(report.Duplicates()))
+	require.ElementsMatch([]string{xvmPath}, paths(report.NonExecutable()))
+	require.ElementsMatch([]string{brokenPath}, paths(report.BrokenSymlinks()))
+	require.ElementsMatch([]string{loopPath}, paths(report.Errored()))
+
+	for _, p := range report.Plugins {
+		if p.Path == currentEVMPath {
+			require.True(p.Current)
+			require.True(p.OK())
+			require.Equal(EVMName, p.VMName)
+		}
+		if p.Path == loopPath {
+			require.Error(p.Err)
+			require.True(p.Known)
+			require.False(p.OK())
+		}
+	}
+}
@@ -6,8 +6,10 @@ package constants
 import "time"
 
 // QuasarActivationTime provides network activation times for Quasar Edition.
-var QuasarActivationTime = map[uint32]time.Time{
+// Prefer DefaultUpgradeSchedule, which also covers custom networks.
+var QuasarActivationTime = map[NetworkID]time.Time{
 	TestnetID:      time.Date(2024, time.November, 25, 16, 0, 0, 0, time.UTC),
 	MainnetID:      time.Date(2024, time.December, 16, 17, 0, 0, 0, time.UTC),
+	DevnetID:       time.Unix(0, 0), // Devnet activates at genesis
 	LocalNetworkID: time.Unix(0, 0), // Local networks activate immediately (Unix epoch)
 }
@@ -51,10 +51,12 @@ type NetworkGRPCPorts struct {
 
 // NetworkPorts holds all port configuration for a network type
 type NetworkPorts struct {
-	GRPC      int // lux-server gRPC port
-	Gateway   int // lux-server gateway port
-	NodeBase  int // First node API port (each node uses 2 ports)
-	NetworkID uint32
+	GRPC     int // lux-server gRPC port
+	Gateway  int // lux-server gateway port
+	NodeBase int // First node API port (each node uses 2 ports)
+
+	NetworkID  NetworkID  // Primary network ID (P-Chain)
+	EVMChainID EVMChainID // C-Chain EIP-155 chain ID
 }
 
 // GetGRPCPorts returns the gRPC ports for a given network type
@@ -118,38 +120,43 @@ func GetNetworkPorts(networkType string) NetworkPorts {
 	switch networkType {
 	case "mainnet":
 		return NetworkPorts{
-			GRPC:      GRPCPortMainnet,
-			Gateway:   GRPCGatewayPortMainnet,
-			NodeBase:  NodePortMainnet,
-			NetworkID: MainnetChainID,
+			GRPC:       GRPCPortMainnet,
+			Gateway:    GRPCGatewayPortMainnet,
+			NodeBase:   NodePortMainnet,
+			NetworkID:  MainnetID,
+			EVMChainID: MainnetChainID,
 		}
 	case "testnet":
 		return NetworkPorts{
-			GRPC:      GRPCPortTestnet,
-			Gateway:   GRPCGatewayPortTestnet,
-			NodeBase:  NodePortTestnet,
-			NetworkID: TestnetChainID,
+			GRPC:       GRPCPortTestnet,
+			Gateway:    GRPCGatewayPortTestnet,
+			NodeBase:   NodePortTestnet,
+			NetworkID:  TestnetID,
+			EVMChainID: TestnetChainID,
 		}
 	case "devnet":
 		return NetworkPorts{
-			GRPC:      GRPCPortDevnet,
-			Gateway:   GRPCGatewayPortDevnet,
-			NodeBase:  NodePortDevnet,
-			NetworkID: 96370, // devnet network ID
+			GRPC:       GRPCPortDevnet,
+			Gateway:    GRPCGatewayPortDevnet,
+			NodeBase:   NodePortDevnet,
+			NetworkID:  DevnetID,
+			EVMChainID: DevnetChainID,
 		}
 	case "dev":
 		return NetworkPorts{
-			GRPC:      GRPCPortDev,
-			Gateway:   GRPCGatewayPortDev,
-			NodeBase:  NodePortDev,
-			NetworkID: CustomID, // 1337 for dev mode (Anvil-compatible)
+			GRPC:       GRPCPortDev,
+			Gateway:    GRPCGatewayPortDev,
+			NodeBase:   NodePortDev,
+			NetworkID:  LocalID,      // 1337 for dev mode
+			EVMChainID: LocalChainID, // 31337 (Anvil-compatible)
 		}
 	case "local", "custom": // "custom" is deprecated alias for "local"
 		return NetworkPorts{
-			GRPC:      GRPCPortCustom,
-			Gateway:   GRPCGatewayPortCustom,
-			NodeBase:  NodePortCustom,
-			NetworkID: CustomID, // 1337 for custom development
+			GRPC:       GRPCPortCustom,
+			Gateway:    GRPCGatewayPortCustom,
+			NodeBase:   NodePortCustom,
+			NetworkID:  LocalID, // 1337 for custom development
+			EVMChainID: LocalChainID,
 		}
 	default:
 		return NetworkPorts{
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrStakeStartTooSoon     = errors.New("stake starts too soon")
+	ErrStakeEndBeforeStart   = errors.New("stake ends before it starts")
+	ErrStakeDurationTooShort = errors.New("stake duration too short")
+	ErrStakeDurationTooLong  = errors.New("stake duration too long")
+	ErrStakeWeightTooLow     = errors.New("stake weight too low")
+	ErrStakeWeightTooHigh    = errors.New("stake weight too high")
+)
+
+// StakingParams are the limits a validator's stake must respect.
+type StakingParams struct {
+	MinStakeDuration time.Duration `json:"minStakeDuration"`
+	MaxStakeDuration time.Duration `json:"maxStakeDuration"`
+	MinStakeWeight   uint64        `json:"minStakeWeight"`
+	// MaxStakeWeight is the largest allowed weight, or 0 for no limit.
+	MaxStakeWeight uint64 `json:"maxStakeWeight,omitempty"`
+	// MinStartLeadTime is how far in the future a stake must start.
+	MinStartLeadTime time.Duration `json:"minStartLeadTime"`
+	// DefaultStakeWeight is the weight tools use when none is given.
+	DefaultStakeWeight uint64 `json:"defaultStakeWeight"`
+}
+
+var (
+	// PrimaryNetworkStakingParams apply to validators of Mainnet, Testnet
+	// and every custom network. They are the primary network limits the
+	// node enforces; the standalone staking constants of the CLI section
+	// (MinStakeDuration, DefaultStakeWeight, ...) mirror these fields.
+	PrimaryNetworkStakingParams = StakingParams{
+		MinStakeDuration:   24 * 14 * time.Hour,  // 2 weeks
+		MaxStakeDuration:   24 * 365 * time.Hour, // 1 year
+		MinStakeWeight:     1,
+		MinStartLeadTime:   time.Minute,
+		DefaultStakeWeight: 20,
+	}
+
+	// DevStakingParams apply to Devnet and Local only. Their durations are
+	// not enforced by any chain: they are this package's defaults for
+	// throwaway networks, short enough to cycle validators within a test
+	// run. Custom networks don't use them; see StakingParamsFor.
+	DevStakingParams = StakingParams{
+		MinStakeDuration:   time.Hour,
+		MaxStakeDuration:   24 * 14 * time.Hour, // 2 weeks
+		MinStakeWeight:     1,
+		MinStartLeadTime:   25 * time.Second,
+		DefaultStakeWeight: 20,
+	}
+
+	// L1PoSStakingParams is the template for validators of proof-of-stake
+	// L1s. Each L1's validator manager sets its own limits, so tools copy
+	// this, override the fields the manager configures and validate with
+	// StakingParams.ValidateStake. It doesn't depend on the primary network
+	// the L1 is attached to, so StakingParamsFor never returns it.
+	L1PoSStakingParams = StakingParams{
+		MinStakeDuration:   24 * time.Hour,
+		MaxStakeDuration:   24 * 365 * time.Hour, // 1 year
+		MinStakeWeight:     1,
+		MinStartLeadTime:   25 * time.Second,
+		DefaultStakeWeight: 20,
+	}
+
+	// NetworkStakingParams holds the primary network staking params of the
+	// well-known networks. Other networks use PrimaryNetworkStakingParams.
+	NetworkStakingParams = map[NetworkID]StakingParams{
+		MainnetID: PrimaryNetworkStakingParams,
+		TestnetID: PrimaryNetworkStakingParams,
+		DevnetID:  DevStakingParams,
+		LocalID:   DevStakingParams,
+	}
+)
+
+// StakingParamsFor returns the primary network staking params of
+// [networkID]. Custom networks get PrimaryNetworkStakingParams, matching
+// NetworkPolicy, which treats them more strictly than dev networks.
+func StakingParamsFor(networkID NetworkID) StakingParams {
+	if p, ok := NetworkStakingParams[networkID]; ok {
+		return p
+	}
+	return PrimaryNetworkStakingParams
+}
+
+// ValidateStake checks a stake of [weight] from [start] to [end] submitted
+// at [now]. It returns every violated limit joined into one error, or nil.
+func (p StakingParams) ValidateStake(start, end time.Time, weight uint64, now time.Time) error {
+	var errs []error
+	if earliest := now.Add(p.MinStartLeadTime); start.Before(earliest) {
+		errs = append(errs, fmt.Errorf("%w: starts at %s, must start at or after %s",
+			ErrStakeStartTooSoon, start.Format(time.RFC3339), earliest.Format(time.RFC3339)))
+	}
+
+	if !end.After(start) {
+		errs = append(errs, fmt.Errorf("%w: starts at %s, ends at %s",
+			ErrStakeEndBeforeStart, start.Format(time.RFC3339), end.Format(time.RFC3339)))
+	} else {
+		duration := end.Sub(start)
+		if duration < p.MinStakeDuration {
+			errs = append(errs, fmt.Errorf("%w: %s < %s", ErrStakeDurationTooShort, duration, p.MinStakeDuration))
+		}
+		if duration > p.MaxStakeDuration {
+			errs = append(errs, fmt.Errorf("%w: %s > %s", ErrStakeDurationTooLong, duration, p.MaxStakeDuration))
+		}
+	}
+
+	if weight < p.MinStakeWeight {
+		errs = append(errs, fmt.Errorf("%w: %d < %d", ErrStakeWeightTooLow, weight, p.MinStakeWeight))
+	}
+	if p.MaxStakeWeight != 0 && weight > p.MaxStakeWeight {
+		errs = append(errs, fmt.Errorf("%w: %d > %d", ErrStakeWeightTooHigh, weight, p.MaxStakeWeight))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateStake checks a primary network stake on [networkID] against
+// StakingParamsFor([networkID]). See StakingParams.ValidateStake. Stakes on
+// L1s are checked against the L1's own params instead, e.g. a copy of
+// L1PoSStakingParams.
+func ValidateStake(networkID NetworkID, start, end time.Time, weight uint64, now time.Time) error {
+	return StakingParamsFor(networkID).ValidateStake(start, end, weight, now)
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStake(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	start := now.Add(StakingStartLeadTime)
+	tests := []struct {
+		name         string
+		networkID    NetworkID
+		start        time.Time
+		end          time.Time
+		weight       uint64
+		expectedErrs []error
+	}{
+		{
+			name:      "valid",
+			networkID: MainnetID,
+			start:     start,
+			end:       start.Add(MinStakeDuration),
+			weight:    MinStakeWeight,
+		},
+		{
+			name:         "too short on mainnet",
+			networkID:    MainnetID,
+			start:        start,
+			end:          start.Add(24 * time.Hour),
+			weight:       DefaultStakeWeight,
+			expectedErrs: []error{ErrStakeDurationTooShort},
+		},
+		{
+			name:      "a day on local",
+			networkID: LocalID,
+			start:     now.Add(StakingMinimumLeadTime),
+			end:       now.Add(StakingMinimumLeadTime + 24*time.Hour),
+			weight:    DefaultStakeWeight,
+		},
+		{
+			name:      "an hour on local",
+			networkID: LocalID,
+			start:     now.Add(StakingMinimumLeadTime),
+			end:       now.Add(StakingMinimumLeadTime + DevStakingParams.MinStakeDuration),
+			weight:    DefaultStakeWeight,
+		},
+		{
+			name:         "a day on a custom network",
+			networkID:    12345,
+			start:        now.Add(StakingStartLeadTime),
+			end:          now.Add(StakingStartLeadTime + 24*time.Hour),
+			weight:       DefaultStakeWeight,
+			expectedErrs: []error{ErrStakeDurationTooShort},
+		},
+		{
+			name:         "every violation",
+			networkID:    TestnetID,
+			start:        now,
+			end:          now.Add(2 * MaxStakeDuration),
+			weight:       0,
+			expectedErrs: []error{ErrStakeStartTooSoon, ErrStakeDurationTooLong, ErrStakeWeightTooLow},
+		},
+		{
+			name:         "ends before start",
+			networkID:    MainnetID,
+			start:        start,
+			end:          start,
+			weight:       DefaultStakeWeight,
+			expectedErrs: []error{ErrStakeEndBeforeStart},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			err := ValidateStake(test.networkID, test.start, test.end, test.weight, now)
+			if len(test.expectedErrs) == 0 {
+				require.NoError(err)
+				return
+			}
+			for _, expectedErr := range test.expectedErrs {
+				require.ErrorIs(err, expectedErr)
+			}
+		})
+	}
+}
+
+func TestL1PoSStakingParams(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	start := now.Add(StakingMinimumLeadTime)
+	end := start.Add(PoSL1MinimumStakeDurationSeconds * time.Second)
+	require.NoError(L1PoSStakingParams.ValidateStake(start, end, BootstrapValidatorWeight, now))
+	require.ErrorIs(PrimaryNetworkStakingParams.ValidateStake(start, end, BootstrapValidatorWeight, now), ErrStakeDurationTooShort)
+
+	require.NotEqual(DevStakingParams, L1PoSStakingParams)
+	require.ErrorIs(DevStakingParams.ValidateStake(start, start.Add(30*24*time.Hour), BootstrapValidatorWeight, now), ErrStakeDurationTooLong)
+	require.NoError(L1PoSStakingParams.ValidateStake(start, start.Add(30*24*time.Hour), BootstrapValidatorWeight, now))
+
+	// L1 validator managers override the template.
+	capped := L1PoSStakingParams
+	capped.MaxStakeWeight = 10
+	require.ErrorIs(capped.ValidateStake(start, end, 11, now), ErrStakeWeightTooHigh)
+}
+
+func TestStakingConstantsMatchParams(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(PrimaryNetworkStakingParams.MinStakeDuration, time.Duration(MinStakeDuration))
+	require.Equal(PrimaryNetworkStakingParams.MaxStakeDuration, time.Duration(MaxStakeDuration))
+	require.Equal(PrimaryNetworkStakingParams.MinStakeWeight, MinStakeWeight)
+	require.Equal(PrimaryNetworkStakingParams.MinStartLeadTime, time.Duration(StakingStartLeadTime))
+	require.Equal(PrimaryNetworkStakingParams.DefaultStakeWeight, uint64(DefaultStakeWeight))
+
+	require.Equal(DevStakingParams.MinStartLeadTime, time.Duration(StakingMinimumLeadTime))
+	require.Equal(DevStakingParams.DefaultStakeWeight, uint64(BootstrapValidatorWeight))
+	require.Equal(L1PoSStakingParams.MinStakeDuration, PoSL1MinimumStakeDurationSeconds*time.Second)
+
+	require.Equal(PrimaryNetworkStakingParams, StakingParamsFor(12345))
+}
@@ -0,0 +1,233 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnknownUpgrade    = errors.New("unknown upgrade")
+	ErrUpgradeOutOfOrder = errors.New("upgrade activates before its predecessor")
+	// ErrProposalBeforeUpgrade is returned when a proposal activates before
+	// the upgrade that includes it.
+	ErrProposalBeforeUpgrade = errors.New("proposal activates before its upgrade")
+)
+
+// UpgradeOverrides is the content of an UpgradeFileName file. It moves
+// activation times on the network it is loaded for, e.g.
+//
+//	{
+//	  "upgrades": {"Durango": "1970-01-01T00:00:00Z", "Quasar Edition": "1970-01-01T00:00:00Z"},
+//	  "proposals": {"ACP-176": "2025-06-01T00:00:00Z"}
+//	}
+type UpgradeOverrides struct {
+	Upgrades  map[string]time.Time     `json:"upgrades,omitempty"`
+	Proposals map[ProposalID]time.Time `json:"proposals,omitempty"`
+}
+
+// ParseUpgradeOverrides parses the content of an UpgradeFileName file.
+// Unknown fields are rejected so that typos don't silently do nothing.
+func ParseUpgradeOverrides(b []byte) (UpgradeOverrides, error) {
+	var o UpgradeOverrides
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&o); err != nil {
+		return UpgradeOverrides{}, fmt.Errorf("invalid %s: %w", UpgradeFileName, err)
+	}
+	return o, nil
+}
+
+// LoadUpgradeSchedule returns DefaultUpgradeSchedule with the overrides in
+// the file at [path] applied to [networkID]. A missing file means no
+// overrides.
+func LoadUpgradeSchedule(networkID NetworkID, path string) (*UpgradeSchedule, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return DefaultUpgradeSchedule, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	o, err := ParseUpgradeOverrides(b)
+	if err != nil {
+		return nil, err
+	}
+	return DefaultUpgradeSchedule.WithOverrides(networkID, o)
+}
+
+// WithOverrides returns a copy of [s] with [o] applied to [networkID]. [s]
+// isn't modified. It errors if [o] names an unknown upgrade or proposal, or
+// if the result fails Verify.
+func (s *UpgradeSchedule) WithOverrides(networkID NetworkID, o UpgradeOverrides) (*UpgradeSchedule, error) {
+	result := &UpgradeSchedule{
+		Upgrades:           make([]NetworkUpgrade, len(s.Upgrades)),
+		ProposalActivation: make(map[ProposalID]map[NetworkID]time.Time, len(s.ProposalActivation)),
+		Clock:              s.Clock,
+	}
+	for i, u := range s.Upgrades {
+		if at, ok := o.Upgrades[u.Name]; ok {
+			u.Activation = maps.Clone(u.Activation)
+			if u.Activation == nil {
+				u.Activation = make(map[NetworkID]time.Time)
+			}
+			u.Activation[networkID] = at
+		}
+		result.Upgrades[i] = u
+	}
+	for name := range o.Upgrades {
+		if _, ok := s.Upgrade(name); !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownUpgrade, name)
+		}
+	}
+
+	for id, activation := range s.ProposalActivation {
+		result.ProposalActivation[id] = maps.Clone(activation)
+	}
+	for id, at := range o.Proposals {
+		if _, ok := LookupProposal(id); !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownProposal, id)
+		}
+		if result.ProposalActivation[id] == nil {
+			result.ProposalActivation[id] = make(map[NetworkID]time.Time)
+		}
+		result.ProposalActivation[id][networkID] = at
+	}
+
+	if err := result.Verify(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Verify checks that, on every network, each upgrade activates no earlier
+// than the upgrade before it and each proposal activates no earlier than its
+// upgrade. Proposals of an upgrade that isn't scheduled on a network may
+// activate on their own there.
+func (s *UpgradeSchedule) Verify() error {
+	// Networks without an explicit time use CustomActivation; CustomID
+	// stands in for all of them.
+	networkIDs := []NetworkID{CustomID}
+	for _, u := range s.Upgrades {
+		for networkID := range u.Activation {
+			networkIDs = append(networkIDs, networkID)
+		}
+	}
+	for _, activation := range s.ProposalActivation {
+		for networkID := range activation {
+			networkIDs = append(networkIDs, networkID)
+		}
+	}
+	for _, p := range Proposals {
+		for networkID := range p.Activation {
+			networkIDs = append(networkIDs, networkID)
+		}
+	}
+	for _, networkID := range networkIDs {
+		for i := 1; i < len(s.Upgrades); i++ {
+			prev, u := s.Upgrades[i-1], s.Upgrades[i]
+			prevAt, at := prev.ActivationTime(networkID), u.ActivationTime(networkID)
+			if at.Before(prevAt) {
+				return fmt.Errorf("%w: %s at %s before %s at %s on network %s",
+					ErrUpgradeOutOfOrder,
+					u.Name, at.Format(time.RFC3339),
+					prev.Name, prevAt.Format(time.RFC3339),
+					networkID,
+				)
+			}
+		}
+		for _, p := range Proposals {
+			u, ok := s.Upgrade(p.Upgrade)
+			if !ok || !u.IsScheduled(networkID) {
+				continue
+			}
+			at, ok := s.ProposalActivationTime(p, networkID)
+			if upgradeAt := u.ActivationTime(networkID); ok && at.Before(upgradeAt) {
+				return fmt.Errorf("%w: %s at %s before %s at %s on network %s",
+					ErrProposalBeforeUpgrade,
+					p.ID, at.Format(time.RFC3339),
+					u.Name, upgradeAt.Format(time.RFC3339),
+					networkID,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// EffectiveProposal is a proposal's activation in an EffectiveSchedule.
+type EffectiveProposal struct {
+	ID         ProposalID `json:"id"`
+	Scheduled  bool       `json:"scheduled"`
+	Activation time.Time  `json:"activation,omitzero"`
+}
+
+// EffectiveUpgrade is an upgrade's activation in an EffectiveSchedule.
+type EffectiveUpgrade struct {
+	Name       string              `json:"name"`
+	Scheduled  bool                `json:"scheduled"`
+	Activation time.Time           `json:"activation,omitzero"`
+	Proposals  []EffectiveProposal `json:"proposals,omitempty"`
+}
+
+// EffectiveSchedule reports when each upgrade and proposal activates on one
+// network once all overrides are applied.
+type EffectiveSchedule struct {
+	NetworkID NetworkID          `json:"networkID"`
+	Upgrades  []EffectiveUpgrade `json:"upgrades"`
+}
+
+// Effective reports the schedule of [networkID].
+func (s *UpgradeSchedule) Effective(networkID NetworkID) EffectiveSchedule {
+	report := EffectiveSchedule{
+		NetworkID: networkID,
+		Upgrades:  make([]EffectiveUpgrade, len(s.Upgrades)),
+	}
+	for i, u := range s.Upgrades {
+		eu := EffectiveUpgrade{
+			Name:      u.Name,
+			Scheduled: u.IsScheduled(networkID),
+		}
+		if eu.Scheduled {
+			eu.Activation = u.ActivationTime(networkID)
+		}
+		for _, p := range Proposals {
+			if p.Upgrade != u.Name {
+				continue
+			}
+			ep := EffectiveProposal{ID: p.ID}
+			ep.Activation, ep.Scheduled = s.ProposalActivationTime(p, networkID)
+			eu.Proposals = append(eu.Proposals, ep)
+		}
+		report.Upgrades[i] = eu
+	}
+	return report
+}
+
+func (r EffectiveSchedule) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "network %s (%d)\n", r.NetworkID, uint32(r.NetworkID))
+	for _, u := range r.Upgrades {
+		fmt.Fprintf(&sb, "  %-16s %s\n", u.Name, formatActivation(u.Scheduled, u.Activation))
+		for _, p := range u.Proposals {
+			fmt.Fprintf(&sb, "    %-14s %s\n", p.ID, formatActivation(p.Scheduled, p.Activation))
+		}
+	}
+	return sb.String()
+}
+
+func formatActivation(scheduled bool, at time.Time) string {
+	if !scheduled {
+		return "unscheduled"
+	}
+	return at.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// customNetworkID runs released upgrades from genesis and nothing else.
+const customNetworkID NetworkID = 12345
+
+func TestParseUpgradeOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		json        string
+		expectedErr error
+	}{
+		{
+			name: "activate next upgrade",
+			json: `{"upgrades": {"Next": "2030-01-01T00:00:00Z"}}`,
+		},
+		{
+			name: "activate proposal",
+			json: `{"proposals": {"acp-176": "2030-01-01T00:00:00Z"}}`,
+		},
+		{
+			name:        "unknown upgrade",
+			json:        `{"upgrades": {"Apricot": "2030-01-01T00:00:00Z"}}`,
+			expectedErr: ErrUnknownUpgrade,
+		},
+		{
+			name:        "unknown proposal",
+			json:        `{"proposals": {"LP-1": "2030-01-01T00:00:00Z"}}`,
+			expectedErr: ErrUnknownProposal,
+		},
+		{
+			name:        "invalid proposal",
+			json:        `{"proposals": {"EIP-1559": "2030-01-01T00:00:00Z"}}`,
+			expectedErr: ErrInvalidProposalID,
+		},
+		{
+			name:        "out of order",
+			json:        `{"upgrades": {"Durango": "2030-01-01T00:00:00Z"}}`,
+			expectedErr: ErrUpgradeOutOfOrder,
+		},
+		{
+			name: "proposal before its upgrade",
+			json: `{
+				"upgrades": {"Quasar Edition": "2030-01-01T00:00:00Z"},
+				"proposals": {"ACP-131": "2029-01-01T00:00:00Z"}
+			}`,
+			expectedErr: ErrProposalBeforeUpgrade,
+		},
+		{
+			name: "proposal with its upgrade",
+			json: `{
+				"upgrades": {"Quasar Edition": "2030-01-01T00:00:00Z"},
+				"proposals": {"ACP-131": "2030-01-01T00:00:00Z"}
+			}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o, err := ParseUpgradeOverrides([]byte(test.json))
+			if err == nil {
+				_, err = DefaultUpgradeSchedule.WithOverrides(customNetworkID, o)
+			}
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+
+	_, err := ParseUpgradeOverrides([]byte(`{"upgrade": {}}`))
+	require.ErrorContains(t, err, "unknown field")
+}
+
+func TestVerifyProposalOrder(t *testing.T) {
+	require := require.New(t)
+
+	quasarMainnet := QuasarActivationTime[MainnetID]
+	s := &UpgradeSchedule{
+		Upgrades: DefaultUpgradeSchedule.Upgrades,
+		ProposalActivation: map[ProposalID]map[NetworkID]time.Time{
+			ACPID(77): {MainnetID: quasarMainnet.Add(-time.Second)},
+		},
+	}
+	require.ErrorIs(s.Verify(), ErrProposalBeforeUpgrade)
+
+	s.ProposalActivation[ACPID(77)][MainnetID] = quasarMainnet
+	require.NoError(s.Verify())
+
+	// Proposals of an unscheduled upgrade may activate on their own.
+	s.ProposalActivation[ACPID(176)] = map[NetworkID]time.Time{MainnetID: quasarMainnet}
+	require.NoError(s.Verify())
+
+	// LP-176 runs from genesis on local networks, so Next can't come later.
+	_, err := DefaultUpgradeSchedule.WithOverrides(LocalID, UpgradeOverrides{
+		Upgrades: map[string]time.Time{NextUpgradeName: quasarMainnet},
+	})
+	require.ErrorIs(err, ErrProposalBeforeUpgrade)
+}
+
+func TestLoadUpgradeSchedule(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, UpgradeFileName)
+
+	s, err := LoadUpgradeSchedule(customNetworkID, path)
+	require.NoError(err)
+	require.Same(DefaultUpgradeSchedule, s)
+
+	activation := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(os.WriteFile(path, []byte(`{
+		"upgrades": {"Next": "2030-01-01T00:00:00Z"},
+		"proposals": {"ACP-77": "2029-01-01T00:00:00Z"}
+	}`), 0o600))
+
+	s, err = LoadUpgradeSchedule(customNetworkID, path)
+	require.NoError(err)
+
+	// Only the overridden network moves.
+	require.True(s.IsActivated(NextUpgradeName, customNetworkID, activation))
+	require.False(s.IsActivated(NextUpgradeName, MainnetID, activation))
+	require.False(DefaultUpgradeSchedule.IsActivated(NextUpgradeName, customNetworkID, activation))
+	require.True(s.IsProposalActive(ACPID(176), customNetworkID, activation))
+	require.False(s.IsProposalActive(ACPID(176), customNetworkID, activation.Add(-time.Second)))
+	require.False(IsActive(ACPID(176), customNetworkID, activation))
+	require.False(s.IsProposalActive(ACPID(77), customNetworkID, activation.AddDate(-1, 0, -1)))
+	require.True(IsActive(ACPID(77), customNetworkID, activation.AddDate(-1, 0, -1)))
+
+	report := s.Effective(customNetworkID)
+	require.Len(report.Upgrades, len(DefaultUpgradeSchedule.Upgrades))
+	next := report.Upgrades[2]
+	require.Equal(NextUpgradeName, next.Name)
+	require.True(next.Scheduled)
+	require.Equal(activation, next.Activation)
+	require.Contains(report.String(), "Next             2030-01-01T00:00:00Z")
+
+	b, err := json.Marshal(DefaultUpgradeSchedule.Effective(MainnetID))
+	require.NoError(err)
+	require.Contains(string(b), `"networkID":"mainnet"`)
+	require.Contains(string(b), `{"name":"Next","scheduled":false,"proposals":[`)
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import "time"
+
+// Network upgrade names, in activation order.
+const (
+	DurangoUpgradeName = "Durango"
+	QuasarUpgradeName  = "Quasar Edition"
+	// NextUpgradeName is the upgrade that hasn't been named or scheduled yet.
+	NextUpgradeName = "Next"
+)
+
+var (
+	// GenesisActivationTime activates an upgrade from a network's genesis.
+	GenesisActivationTime = time.Unix(0, 0).UTC()
+
+	// UnscheduledActivationTime is used for upgrades that have no activation
+	// time yet. It is far enough in the future to never be reached.
+	UnscheduledActivationTime = time.Date(9999, time.December, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// NetworkUpgrade is a named upgrade with its activation time on each network.
+type NetworkUpgrade struct {
+	Name string
+	// Activation holds the activation time on each well-known network.
+	Activation map[NetworkID]time.Time
+	// CustomActivation is the activation time on every network missing from
+	// Activation.
+	CustomActivation time.Time
+}
+
+// ActivationTime returns when [u] activates on [networkID].
+func (u NetworkUpgrade) ActivationTime(networkID NetworkID) time.Time {
+	if at, ok := u.Activation[networkID]; ok {
+		return at
+	}
+	return u.CustomActivation
+}
+
+// IsScheduled reports whether [u] has an activation time on [networkID].
+func (u NetworkUpgrade) IsScheduled(networkID NetworkID) bool {
+	return u.ActivationTime(networkID).Before(UnscheduledActivationTime)
+}
+
+// UpgradeSchedule is an ordered list of network upgrades. Each upgrade
+// activates no earlier than the one before it on every network.
+type UpgradeSchedule struct {
+	Upgrades []NetworkUpgrade
+	// ProposalActivation overrides when individual proposals activate,
+	// regardless of their upgrade.
+	ProposalActivation map[ProposalID]map[NetworkID]time.Time
+	// Clock returns the current time. If nil, time.Now is used.
+	Clock func() time.Time
+}
+
+// DefaultUpgradeSchedule is the schedule shipped with this release. Released
+// upgrades activate at genesis on custom networks; the next upgrade isn't
+// scheduled anywhere.
+var DefaultUpgradeSchedule = &UpgradeSchedule{
+	Upgrades: []NetworkUpgrade{
+		{
+			Name:             DurangoUpgradeName,
+			Activation:       DurangoActivationTime,
+			CustomActivation: GenesisActivationTime,
+		},
+		{
+			Name:             QuasarUpgradeName,
+			Activation:       QuasarActivationTime,
+			CustomActivation: GenesisActivationTime,
+		},
+		{
+			Name:             NextUpgradeName,
+			CustomActivation: UnscheduledActivationTime,
+		},
+	},
+}
+
+// Now returns the current time according to the schedule's clock.
+func (s *UpgradeSchedule) Now() time.Time {
+	if s.Clock == nil {
+		return time.Now()
+	}
+	return s.Clock()
+}
+
+// Upgrade returns the upgrade named [name].
+func (s *UpgradeSchedule) Upgrade(name string) (NetworkUpgrade, bool) {
+	for _, u := range s.Upgrades {
+		if u.Name == name {
+			return u, true
+		}
+	}
+	return NetworkUpgrade{}, false
+}
+
+// IsActivated reports whether the upgrade named [name] is active on
+// [networkID] at [t]. Unknown upgrades are never active.
+func (s *UpgradeSchedule) IsActivated(name string, networkID NetworkID, t time.Time) bool {
+	u, ok := s.Upgrade(name)
+	return ok && !t.Before(u.ActivationTime(networkID))
+}
+
+// IsActivatedNow is IsActivated at the schedule's current time.
+func (s *UpgradeSchedule) IsActivatedNow(name string, networkID NetworkID) bool {
+	return s.IsActivated(name, networkID, s.Now())
+}
+
+// NextUpgrade returns the first upgrade that isn't active on [networkID] at
+// [t], or false if every upgrade is active. The returned upgrade may be
+// unscheduled; see NetworkUpgrade.IsScheduled.
+func (s *UpgradeSchedule) NextUpgrade(networkID NetworkID, t time.Time) (NetworkUpgrade, bool) {
+	for _, u := range s.Upgrades {
+		if t.Before(u.ActivationTime(networkID)) {
+			return u, true
+		}
+	}
+	return NetworkUpgrade{}, false
+}
+
+// NextUpgradeNow is NextUpgrade at the schedule's current time.
+func (s *UpgradeSchedule) NextUpgradeNow(networkID NetworkID) (NetworkUpgrade, bool) {
+	return s.NextUpgrade(networkID, s.Now())
+}
+
+// ProposalActivationTime returns when [p] activates on [networkID]. An
+// override in the schedule wins, then p.Activation, then the activation of
+// p.Upgrade. It returns false if the time found is unscheduled; a proposal's
+// status doesn't make it active.
+func (s *UpgradeSchedule) ProposalActivationTime(p Proposal, networkID NetworkID) (time.Time, bool) {
+	at, ok := s.ProposalActivation[p.ID][networkID]
+	if !ok {
+		at, ok = p.Activation[networkID]
+	}
+	if !ok {
+		u, ok := s.Upgrade(p.Upgrade)
+		if !ok {
+			return time.Time{}, false
+		}
+		at = u.ActivationTime(networkID)
+	}
+	if !at.Before(UnscheduledActivationTime) {
+		return time.Time{}, false
+	}
+	return at, true
+}
+
+// IsProposalActive reports whether the proposal [id] is active on
+// [networkID] at [t]. Proposals missing from the catalog are never active.
+func (s *UpgradeSchedule) IsProposalActive(id ProposalID, networkID NetworkID, t time.Time) bool {
+	p, ok := LookupProposal(id)
+	if !ok {
+		return false
+	}
+	at, ok := s.ProposalActivationTime(p, networkID)
+	return ok && !t.Before(at)
+}
+
+// ActiveProposals returns the proposals active on [networkID] at [t], in
+// catalog order.
+func (s *UpgradeSchedule) ActiveProposals(networkID NetworkID, t time.Time) []ProposalID {
+	var active []ProposalID
+	for _, p := range Proposals {
+		if at, ok := s.ProposalActivationTime(p, networkID); ok && !t.Before(at) {
+			active = append(active, p.ID)
+		}
+	}
+	return active
+}
+
+// IsActivated reports whether the upgrade named [name] is active on
+// [networkID] at [t] according to DefaultUpgradeSchedule.
+func IsActivated(name string, networkID NetworkID, t time.Time) bool {
+	return DefaultUpgradeSchedule.IsActivated(name, networkID, t)
+}
+
+// NextUpgrade returns the next upgrade on [networkID] after [t] according to
+// DefaultUpgradeSchedule.
+func NextUpgrade(networkID NetworkID, t time.Time) (NetworkUpgrade, bool) {
+	return DefaultUpgradeSchedule.NextUpgrade(networkID, t)
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeScheduleIsActivated(t *testing.T) {
+	quasarMainnet := QuasarActivationTime[MainnetID]
+	tests := []struct {
+		name      string
+		upgrade   string
+		networkID NetworkID
+		time      time.Time
+		expected  bool
+	}{
+		{
+			name:      "before activation",
+			upgrade:   QuasarUpgradeName,
+			networkID: MainnetID,
+			time:      quasarMainnet.Add(-time.Second),
+		},
+		{
+			name:      "at activation",
+			upgrade:   QuasarUpgradeName,
+			networkID: MainnetID,
+			time:      quasarMainnet,
+			expected:  true,
+		},
+		{
+			name:      "devnet",
+			upgrade:   QuasarUpgradeName,
+			networkID: DevnetID,
+			time:      GenesisActivationTime,
+			expected:  true,
+		},
+		{
+			name:      "custom network",
+			upgrade:   DurangoUpgradeName,
+			networkID: 12345,
+			time:      GenesisActivationTime,
+			expected:  true,
+		},
+		{
+			name:      "unscheduled",
+			upgrade:   NextUpgradeName,
+			networkID: LocalID,
+			time:      time.Now(),
+		},
+		{
+			name:      "unknown upgrade",
+			upgrade:   "Apricot",
+			networkID: LocalID,
+			time:      time.Now(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, IsActivated(test.upgrade, test.networkID, test.time))
+		})
+	}
+}
+
+func TestUpgradeScheduleNextUpgrade(t *testing.T) {
+	require := require.New(t)
+
+	now := QuasarActivationTime[MainnetID].Add(-time.Hour)
+	s := &UpgradeSchedule{
+		Upgrades: DefaultUpgradeSchedule.Upgrades,
+		Clock:    func() time.Time { return now },
+	}
+
+	u, ok := s.NextUpgradeNow(MainnetID)
+	require.True(ok)
+	require.Equal(QuasarUpgradeName, u.Name)
+	require.True(u.IsScheduled(MainnetID))
+	require.True(s.IsActivatedNow(DurangoUpgradeName, MainnetID))
+	require.False(s.IsActivatedNow(QuasarUpgradeName, MainnetID))
+
+	now = QuasarActivationTime[MainnetID]
+	u, ok = s.NextUpgradeNow(MainnetID)
+	require.True(ok)
+	require.Equal(NextUpgradeName, u.Name)
+	require.False(u.IsScheduled(MainnetID))
+
+	_, ok = s.NextUpgrade(MainnetID, UnscheduledActivationTime)
+	require.False(ok)
+}
@@ -0,0 +1,249 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidSemver  = errors.New("invalid semantic version")
+	ErrUnknownVersion = errors.New("version not in compatibility matrix")
+
+	// DefaultEVMSemver, LatestEVMSemver and DefaultLuxdSemver are the typed
+	// forms of DefaultEVMVersion, LatestEVMVersion and DefaultLuxdVersion.
+	DefaultEVMSemver  = MustParseSemver(DefaultEVMVersion)
+	LatestEVMSemver   = MustParseSemver(LatestEVMVersion)
+	DefaultLuxdSemver = MustParseSemver(DefaultLuxdVersion)
+)
+
+// RPCProtocolVersion is the rpcchainvm protocol version a node and a VM
+// plugin must agree on.
+type RPCProtocolVersion uint32
+
+// DefaultEVMRPCProtocol is the typed form of DefaultEVMRPCVersion.
+const DefaultEVMRPCProtocol RPCProtocolVersion = DefaultEVMRPCVersion
+
+// Semver is a semantic version, written with a leading "v" as in the
+// release tags ("v0.8.13"). Build metadata is accepted and dropped.
+//
+// See: https://semver.org
+type Semver struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease string
+}
+
+// ParseSemver parses "v1.2.3", "1.2.3" or "v1.2.3-rc.1+build".
+func ParseSemver(s string) (Semver, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	v, _, _ = strings.Cut(v, "+")
+	v, prerelease, hasPrerelease := strings.Cut(v, "-")
+	if hasPrerelease && prerelease == "" {
+		return Semver{}, fmt.Errorf("%w: %q", ErrInvalidSemver, s)
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return Semver{}, fmt.Errorf("%w: %q", ErrInvalidSemver, s)
+	}
+	var nums [3]uint64
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil || (len(part) > 1 && part[0] == '0') {
+			return Semver{}, fmt.Errorf("%w: %q", ErrInvalidSemver, s)
+		}
+		nums[i] = n
+	}
+	return Semver{
+		Major:      nums[0],
+		Minor:      nums[1],
+		Patch:      nums[2],
+		Prerelease: prerelease,
+	}, nil
+}
+
+// MustParseSemver is ParseSemver that panics on error, for constants.
+func MustParseSemver(s string) Semver {
+	v, err := ParseSemver(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (v Semver) String() string {
+	s := fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 following semver precedence.
+func (v Semver) Compare(o Semver) int {
+	if c := cmp.Compare(v.Major, o.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Minor, o.Minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Patch, o.Patch); c != 0 {
+		return c
+	}
+	switch {
+	case v.Prerelease == o.Prerelease:
+		return 0
+	case v.Prerelease == "":
+		return 1
+	case o.Prerelease == "":
+		return -1
+	}
+
+	a, b := strings.Split(v.Prerelease, "."), strings.Split(o.Prerelease, ".")
+	for i := 0; i < len(a) && i < len(b); i++ {
+		an, aErr := strconv.ParseUint(a[i], 10, 64)
+		bn, bErr := strconv.ParseUint(b[i], 10, 64)
+		var c int
+		switch {
+		case aErr == nil && bErr == nil:
+			c = cmp.Compare(an, bn)
+		case aErr == nil:
+			c = -1 // numeric identifiers sort before alphanumeric ones
+		case bErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(a[i], b[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// MarshalText writes the version as String does.
+func (v Semver) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText parses the version as ParseSemver does.
+func (v *Semver) UnmarshalText(text []byte) error {
+	parsed, err := ParseSemver(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// CompatibilityMatrix records which rpcchainvm protocol version each node
+// and EVM release speaks. A node and an EVM plugin are compatible when they
+// speak the same protocol version.
+type CompatibilityMatrix struct {
+	NodeRPC map[Semver]RPCProtocolVersion
+	EVMRPC  map[Semver]RPCProtocolVersion
+}
+
+// evmCompatibility is the format published at EVMRPCCompatibilityURL.
+type evmCompatibility struct {
+	RPCChainVMProtocolVersion map[Semver]RPCProtocolVersion `json:"rpcChainVMProtocolVersion"`
+}
+
+// ParseCompatibility builds a matrix from the node compatibility JSON
+// published at LuxCompatibilityURL, which maps each protocol version to the
+// node releases speaking it ({"42": ["v1.21.0"]}), and the EVM compatibility
+// JSON published at EVMRPCCompatibilityURL, which maps each EVM release to
+// its protocol version ({"rpcChainVMProtocolVersion": {"v0.8.13": 42}}).
+func ParseCompatibility(nodeJSON, evmJSON []byte) (*CompatibilityMatrix, error) {
+	var node map[RPCProtocolVersion][]Semver
+	if err := json.Unmarshal(nodeJSON, &node); err != nil {
+		return nil, fmt.Errorf("failed to parse node compatibility: %w", err)
+	}
+	var evm evmCompatibility
+	if err := json.Unmarshal(evmJSON, &evm); err != nil {
+		return nil, fmt.Errorf("failed to parse EVM compatibility: %w", err)
+	}
+
+	m := &CompatibilityMatrix{
+		NodeRPC: make(map[Semver]RPCProtocolVersion),
+		EVMRPC:  evm.RPCChainVMProtocolVersion,
+	}
+	if m.EVMRPC == nil {
+		m.EVMRPC = make(map[Semver]RPCProtocolVersion)
+	}
+	for rpc, versions := range node {
+		for _, version := range versions {
+			m.NodeRPC[version] = rpc
+		}
+	}
+	return m, nil
+}
+
+// LoadCompatibility reads the node and EVM compatibility files from disk and
+// parses them as ParseCompatibility does.
+func LoadCompatibility(nodePath, evmPath string) (*CompatibilityMatrix, error) {
+	nodeJSON, err := os.ReadFile(nodePath)
+	if err != nil {
+		return nil, err
+	}
+	evmJSON, err := os.ReadFile(evmPath)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompatibility(nodeJSON, evmJSON)
+}
+
+// IsCompatible reports whether [nodeVersion] can run [evmVersion] as a
+// plugin. It errors if either version is missing from the matrix.
+func (m *CompatibilityMatrix) IsCompatible(nodeVersion, evmVersion Semver) (bool, error) {
+	nodeRPC, ok := m.NodeRPC[nodeVersion]
+	if !ok {
+		return false, fmt.Errorf("%w: node %s", ErrUnknownVersion, nodeVersion)
+	}
+	evmRPC, ok := m.EVMRPC[evmVersion]
+	if !ok {
+		return false, fmt.Errorf("%w: EVM %s", ErrUnknownVersion, evmVersion)
+	}
+	return nodeRPC == evmRPC, nil
+}
+
+// NodeVersionsForRPC returns the node releases speaking [rpc], oldest first.
+func (m *CompatibilityMatrix) NodeVersionsForRPC(rpc RPCProtocolVersion) []Semver {
+	return versionsForRPC(m.NodeRPC, rpc)
+}
+
+// EVMVersionsForRPC returns the EVM releases speaking [rpc], oldest first.
+func (m *CompatibilityMatrix) EVMVersionsForRPC(rpc RPCProtocolVersion) []Semver {
+	return versionsForRPC(m.EVMRPC, rpc)
+}
+
+// CompatibleEVMVersions returns the EVM releases that [nodeVersion] can run,
+// oldest first.
+func (m *CompatibilityMatrix) CompatibleEVMVersions(nodeVersion Semver) ([]Semver, error) {
+	rpc, ok := m.NodeRPC[nodeVersion]
+	if !ok {
+		return nil, fmt.Errorf("%w: node %s", ErrUnknownVersion, nodeVersion)
+	}
+	return m.EVMVersionsForRPC(rpc), nil
+}
+
+func versionsForRPC(table map[Semver]RPCProtocolVersion, rpc RPCProtocolVersion) []Semver {
+	var versions []Semver
+	for version, versionRPC := range table {
+		if versionRPC == rpc {
+			versions = append(versions, version)
+		}
+	}
+	slices.SortFunc(versions, Semver.Compare)
+	return versions
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSemver(t *testing.T) {
+	tests := []struct {
+		s           string
+		version     Semver
+		expectedErr error
+	}{
+		{
+			s:       DefaultEVMVersion,
+			version: Semver{Major: 0, Minor: 8, Patch: 0},
+		},
+		{
+			s:       "1.21.0",
+			version: Semver{Major: 1, Minor: 21, Patch: 0},
+		},
+		{
+			s:       "v1.2.3-rc.1+build.5",
+			version: Semver{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"},
+		},
+		{
+			s:           "v1.2",
+			expectedErr: ErrInvalidSemver,
+		},
+		{
+			s:           "v01.2.3",
+			expectedErr: ErrInvalidSemver,
+		},
+		{
+			s:           "v1.2.3-",
+			expectedErr: ErrInvalidSemver,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.s, func(t *testing.T) {
+			require := require.New(t)
+
+			version, err := ParseSemver(test.s)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.version, version)
+		})
+	}
+}
+
+func TestSemverCompare(t *testing.T) {
+	ordered := []string{
+		"v0.8.0",
+		"v0.8.13-alpha",
+		"v0.8.13-alpha.1",
+		"v0.8.13-alpha.beta",
+		"v0.8.13-beta.2",
+		"v0.8.13-beta.11",
+		"v0.8.13",
+		"v1.0.0",
+	}
+	for i := 1; i < len(ordered); i++ {
+		a, b := MustParseSemver(ordered[i-1]), MustParseSemver(ordered[i])
+		require.Equal(t, -1, a.Compare(b), "%s < %s", a, b)
+		require.Equal(t, 1, b.Compare(a), "%s > %s", b, a)
+	}
+	require.Equal(t, -1, DefaultEVMSemver.Compare(LatestEVMSemver))
+}
+
+const (
+	testNodeCompatibility = `{
+		"41": ["v1.20.0", "v1.20.1"],
+		"42": ["v1.21.0"]
+	}`
+	testEVMCompatibility = `{
+		"rpcChainVMProtocolVersion": {
+			"v0.8.0": 41,
+			"v0.8.12": 42,
+			"v0.8.13": 42
+		}
+	}`
+)
+
+func TestCompatibilityMatrix(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	nodePath := filepath.Join(dir, "lux-compatibility.json")
+	evmPath := filepath.Join(dir, "compatibility.json")
+	require.NoError(os.WriteFile(nodePath, []byte(testNodeCompatibility), 0o600))
+	require.NoError(os.WriteFile(evmPath, []byte(testEVMCompatibility), 0o600))
+
+	m, err := LoadCompatibility(nodePath, evmPath)
+	require.NoError(err)
+
+	compatible, err := m.IsCompatible(DefaultLuxdSemver, LatestEVMSemver)
+	require.NoError(err)
+	require.True(compatible)
+
+	compatible, err = m.IsCompatible(DefaultLuxdSemver, DefaultEVMSemver)
+	require.NoError(err)
+	require.False(compatible)
+
+	_, err = m.IsCompatible(MustParseSemver("v9.9.9"), LatestEVMSemver)
+	require.ErrorIs(err, ErrUnknownVersion)
+
+	require.Equal(
+		[]Semver{MustParseSemver("v0.8.12"), MustParseSemver("v0.8.13")},
+		m.EVMVersionsForRPC(DefaultEVMRPCProtocol),
+	)
+	require.Equal(
+		[]Semver{MustParseSemver("v1.20.0"), MustParseSemver("v1.20.1")},
+		m.NodeVersionsForRPC(41),
+	)
+
+	evmVersions, err := m.CompatibleEVMVersions(MustParseSemver("v1.20.1"))
+	require.NoError(err)
+	require.Equal([]Semver{DefaultEVMSemver}, evmVersions)
+}
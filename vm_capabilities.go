@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/luxfi/ids"
+)
+
+// VMCapability is a set of features a VM supports. Tooling should branch on
+// capabilities rather than on VM names.
+type VMCapability uint32
+
+const (
+	// VMCapabilityWarp means the VM signs and verifies Warp messages.
+	VMCapabilityWarp VMCapability = 1 << iota
+	// VMCapabilityEthRPC means the VM serves the Ethereum JSON-RPC API.
+	VMCapabilityEthRPC
+	// VMCapabilityUTXO means the VM uses UTXO-based transactions.
+	VMCapabilityUTXO
+	// VMCapabilityStateSync means the VM can bootstrap via state sync.
+	VMCapabilityStateSync
+	// VMCapabilityStaking means the VM manages validator stake.
+	VMCapabilityStaking
+)
+
+var vmCapabilityNames = []struct {
+	capability VMCapability
+	name       string
+}{
+	{VMCapabilityWarp, "warp"},
+	{VMCapabilityEthRPC, "eth-rpc"},
+	{VMCapabilityUTXO, "utxo"},
+	{VMCapabilityStateSync, "state-sync"},
+	{VMCapabilityStaking, "staking"},
+}
+
+// Has reports whether [c] contains every capability in [other].
+func (c VMCapability) Has(other VMCapability) bool {
+	return c&other == other
+}
+
+func (c VMCapability) String() string {
+	if c == 0 {
+		return "none"
+	}
+	var names []string
+	for _, n := range vmCapabilityNames {
+		if c.Has(n.capability) {
+			names = append(names, n.name)
+			c &^= n.capability
+		}
+	}
+	if c != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(c)))
+	}
+	return strings.Join(names, "|")
+}
+
+// Handler routes served by the built-in VMs, relative to /ext/bc/<chain>.
+const (
+	VMRouteRoot = ""
+	VMRouteRPC  = "/rpc"
+	VMRouteWS   = "/ws"
+)
+
+// SetVMCapabilities records the capabilities and API routes of the
+// registered VM [vmID], replacing any previous values.
+func (r *VMRegistry) SetVMCapabilities(vmID ids.ID, capabilities VMCapability, routes ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info, ok := r.byID[vmID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownVM, vmID)
+	}
+	info.Capabilities = capabilities
+	info.CapabilitiesDeclared = true
+	info.APIRoutes = slices.Clone(routes)
+	return nil
+}
+
+// VMCapabilities returns the capabilities of [vmID]. It returns false if
+// [vmID] isn't registered or its capabilities were never declared.
+func (r *VMRegistry) VMCapabilities(vmID ids.ID) (VMCapability, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.byID[vmID]
+	if !ok || !info.CapabilitiesDeclared {
+		return 0, false
+	}
+	return info.Capabilities, true
+}
+
+// VMHasCapability reports whether [vmID] declared every capability in
+// [capability]. It is false for unknown VMs and VMs that never declared their
+// capabilities, even for the empty set.
+func (r *VMRegistry) VMHasCapability(vmID ids.ID, capability VMCapability) bool {
+	capabilities, ok := r.VMCapabilities(vmID)
+	return ok && capabilities.Has(capability)
+}
+
+// VMAPIRoutes returns the API routes that [vmID] is expected to serve,
+// relative to /ext/bc/<chain>.
+func (r *VMRegistry) VMAPIRoutes(vmID ids.ID) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.byID[vmID]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(info.APIRoutes)
+}
+
+// VMCapabilities returns the capabilities of [vmID] in the
+// DefaultVMRegistry.
+func VMCapabilities(vmID ids.ID) (VMCapability, bool) {
+	return DefaultVMRegistry.VMCapabilities(vmID)
+}
+
+// VMHasCapability reports whether [vmID] is registered in the
+// DefaultVMRegistry with every capability in [capability].
+func VMHasCapability(vmID ids.ID, capability VMCapability) bool {
+	return DefaultVMRegistry.VMHasCapability(vmID, capability)
+}
+
+// VMAPIRoutes returns the API routes of [vmID] in the DefaultVMRegistry.
+func VMAPIRoutes(vmID ids.ID) []string {
+	return DefaultVMRegistry.VMAPIRoutes(vmID)
+}
@@ -5,28 +5,36 @@ package constants
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 
 	"github.com/luxfi/crypto/hash"
 	"github.com/luxfi/ids"
 )
 
+// MaxVMASCIINameLen is the longest VM name that fits in an ids.ID under the
+// ASCII VM ID scheme used by the built-in VMs.
+const MaxVMASCIINameLen = len(ids.ID{})
+
+var ErrInvalidVMName = errors.New("invalid VM name")
+
 const (
-	PlatformVMName  = "platformvm"  // P-Chain: Platform/Validators
-	XVMName         = "xvm"         // X-Chain: UTXO Exchange
-	EVMName         = "evm"         // C-Chain: EVM Smart Contracts
-	XSVMName        = "xsvm"        // Cross-Chain VM
-	QuantumVMName   = "quantumvm"   // Q-Chain: Quantum-resistant security
-	AIVMName        = "aivm"        // A-Chain: AI Virtual Machine
-	BridgeVMName    = "bridgevm"    // B-Chain: Bridge/Cross-chain
-	MPCVMName       = "mpcvm"       // M-Chain: MPC threshold signing / bridge custody (LP-7100)
-	FHEVMName       = "fhevm"       // F-Chain: FHE confidential compute / encrypted state (LP-8200)
-	KeyVMName       = "keyvm"       // K-Chain: Key Management
-	ZKVMName        = "zkvm"        // Z-Chain: Zero-Knowledge proofs
-	GraphVMName     = "graphvm"     // G-Chain: GraphQL/DGraph unified data layer
-	DexVMName       = "dexvm"       // D-Chain: Decentralized Exchange
-	OracleVMName    = "oraclevm"    // O-Chain: Oracle/Off-chain Data
-	RelayVMName     = "relayvm"     // R-Chain: Cross-chain Relay/Messages
-	IdentityVMName  = "identityvm"  // I-Chain: Decentralized Identity
+	PlatformVMName = "platformvm" // P-Chain: Platform/Validators
+	XVMName        = "xvm"        // X-Chain: UTXO Exchange
+	EVMName        = "evm"        // C-Chain: EVM Smart Contracts
+	XSVMName       = "xsvm"       // Cross-Chain VM
+	QuantumVMName  = "quantumvm"  // Q-Chain: Quantum-resistant security
+	AIVMName       = "aivm"       // A-Chain: AI Virtual Machine
+	BridgeVMName   = "bridgevm"   // B-Chain: Bridge/Cross-chain
+	MPCVMName      = "mpcvm"      // M-Chain: MPC threshold signing / bridge custody (LP-7100)
+	FHEVMName      = "fhevm"      // F-Chain: FHE confidential compute / encrypted state (LP-8200)
+	KeyVMName      = "keyvm"      // K-Chain: Key Management
+	ZKVMName       = "zkvm"       // Z-Chain: Zero-Knowledge proofs
+	GraphVMName    = "graphvm"    // G-Chain: GraphQL/DGraph unified data layer
+	DexVMName      = "dexvm"      // D-Chain: Decentralized Exchange
+	OracleVMName   = "oraclevm"   // O-Chain: Oracle/Off-chain Data
+	RelayVMName    = "relayvm"    // R-Chain: Cross-chain Relay/Messages
+	IdentityVMName = "identityvm" // I-Chain: Decentralized Identity
 )
 
 var (
@@ -80,54 +88,84 @@ var (
 // the API stays consistent with UTXO_ASSET_ID and stays brand-neutral —
 // downstream chains (e.g. Hanzo, Zoo, regulated EVM L1s) using this primitive
 // keep their own brand identity.
-func UTXOAssetIDFor(networkID uint32) ids.ID {
-	if networkID == 1 {
+//
+// Use AssetIDFor for any other asset on P-Chain, X-Chain or an L1.
+func UTXOAssetIDFor(networkID NetworkID) ids.ID {
+	if networkID == MainnetID {
 		// Preserve mainnet's existing on-chain state and tooling references.
 		return UTXO_ASSET_ID
 	}
 	var preimage [16]byte
 	copy(preimage[:12], "lux asset id")
-	binary.BigEndian.PutUint32(preimage[12:], networkID)
+	binary.BigEndian.PutUint32(preimage[12:], uint32(networkID))
 	return hash.ComputeHash256Array(preimage[:])
 }
 
-// VMName returns the name of the VM with the provided ID. If a human readable
-// name isn't known, then the formatted ID is returned.
+// VMIDFromASCII derives the canonical ID of the VM [name]: the ASCII bytes
+// of the name, zero-padded to 32 bytes. This is the scheme the built-in VMs
+// use (ContractVMID is "evm"), and third-party VMs should use it too so that
+// VMName can recover their name from the ID alone.
+//
+// Names must be 1 to MaxVMASCIINameLen bytes of lowercase letters, digits,
+// '.', '-' or '_'. Names registered in DefaultVMRegistry under a different
+// ID, such as "xvm" (ExchangeVMID is "avm"), are rejected so that a plugin
+// can't pass itself off as a built-in VM.
+func VMIDFromASCII(name string) (ids.ID, error) {
+	if len(name) == 0 || len(name) > MaxVMASCIINameLen {
+		return ids.Empty, fmt.Errorf("%w: %q must be 1 to %d bytes", ErrInvalidVMName, name, MaxVMASCIINameLen)
+	}
+	for i := 0; i < len(name); i++ {
+		if !isVMNameByte(name[i]) {
+			return ids.Empty, fmt.Errorf("%w: %q contains %q", ErrInvalidVMName, name, name[i])
+		}
+	}
+	var vmID ids.ID
+	copy(vmID[:], name)
+	if registeredID, ok := DefaultVMRegistry.VMIDFromName(name); ok && registeredID != vmID {
+		return ids.Empty, fmt.Errorf("%w: %q is already registered to %s", ErrVMAlreadyRegistered, name, registeredID)
+	}
+	return vmID, nil
+}
+
+// VMNameFromASCII decodes a VM ID derived by VMIDFromASCII back to its name.
+// It returns false if [vmID] doesn't follow the scheme, or if it decodes to
+// a name that DefaultVMRegistry assigns to a different VM.
+func VMNameFromASCII(vmID ids.ID) (string, bool) {
+	n := 0
+	for n < len(vmID) && vmID[n] != 0 {
+		if !isVMNameByte(vmID[n]) {
+			return "", false
+		}
+		n++
+	}
+	if n == 0 {
+		return "", false
+	}
+	for _, b := range vmID[n:] {
+		if b != 0 {
+			return "", false
+		}
+	}
+	name := string(vmID[:n])
+	if registeredID, ok := DefaultVMRegistry.VMIDFromName(name); ok && registeredID != vmID {
+		return "", false
+	}
+	return name, true
+}
+
+func isVMNameByte(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') || b == '.' || b == '-' || b == '_'
+}
+
+// VMName returns the name of the VM with the provided ID, as registered in
+// the DefaultVMRegistry or, failing that, decoded by VMNameFromASCII. If a
+// human readable name isn't known, then the formatted ID is returned.
 func VMName(vmID ids.ID) string {
-	switch vmID {
-	case PlatformVMID:
-		return PlatformVMName
-	case XVMID:
-		return XVMName
-	case EVMID:
-		return EVMName
-	case XSVMID:
-		return XSVMName
-	case QuantumVMID:
-		return QuantumVMName
-	case AIVMID:
-		return AIVMName
-	case BridgeVMID:
-		return BridgeVMName
-	case MPCVMID:
-		return MPCVMName
-	case FHEVMID:
-		return FHEVMName
-	case KeyVMID:
-		return KeyVMName
-	case ZKVMID:
-		return ZKVMName
-	case GraphVMID:
-		return GraphVMName
-	case DexVMID:
-		return DexVMName
-	case OracleVMID:
-		return OracleVMName
-	case RelayVMID:
-		return RelayVMName
-	case IdentityVMID:
-		return IdentityVMName
-	default:
-		return vmID.String()
+	if name, ok := DefaultVMRegistry.VMName(vmID); ok {
+		return name
+	}
+	if name, ok := VMNameFromASCII(vmID); ok {
+		return name
 	}
+	return vmID.String()
 }
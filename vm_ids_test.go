@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/luxfi/ids"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVMIDFromASCII(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectedErr error
+	}{
+		{
+			name: EVMName,
+		},
+		{
+			name: "my-vm_v2.1",
+		},
+		{
+			name: strings.Repeat("a", MaxVMASCIINameLen),
+		},
+		{
+			name:        "",
+			expectedErr: ErrInvalidVMName,
+		},
+		{
+			name:        strings.Repeat("a", MaxVMASCIINameLen+1),
+			expectedErr: ErrInvalidVMName,
+		},
+		{
+			name:        "MyVM",
+			expectedErr: ErrInvalidVMName,
+		},
+		{
+			name:        XVMName,
+			expectedErr: ErrVMAlreadyRegistered,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			vmID, err := VMIDFromASCII(test.name)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			name, ok := VMNameFromASCII(vmID)
+			require.True(ok)
+			require.Equal(test.name, name)
+		})
+	}
+}
+
+func TestVMNameFallback(t *testing.T) {
+	require := require.New(t)
+
+	evmID, err := VMIDFromASCII(EVMName)
+	require.NoError(err)
+	require.Equal(EVMID, evmID)
+
+	customID, err := VMIDFromASCII("unregisteredvm")
+	require.NoError(err)
+	require.Equal("unregisteredvm", VMName(customID))
+
+	// Native chain IDs are zero-prefixed and never decode as VM names.
+	_, ok := VMNameFromASCII(ids.PChainID)
+	require.False(ok)
+	require.Equal(ids.PChainID.String(), VMName(ids.PChainID))
+}
+
+func TestVMNameFromASCIIRejectsSpoofedBuiltin(t *testing.T) {
+	require := require.New(t)
+
+	// "xvm" is the registered name of ExchangeVMID ("avm"), not of the ID
+	// spelling "xvm".
+	var spoofedID ids.ID
+	copy(spoofedID[:], XVMName)
+	require.NotEqual(XVMID, spoofedID)
+
+	_, ok := VMNameFromASCII(spoofedID)
+	require.False(ok)
+	require.Equal(spoofedID.String(), VMName(spoofedID))
+
+	name, ok := VMNameFromASCII(ExchangeVMID)
+	require.True(ok)
+	require.Equal("avm", name)
+	require.Equal(XVMName, VMName(ExchangeVMID))
+}
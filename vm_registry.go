@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/luxfi/ids"
+)
+
+var (
+	ErrVMAlreadyRegistered = errors.New("vm already registered")
+	ErrUnknownVM           = errors.New("unknown vm")
+)
+
+// VMInfo describes a registered VM.
+type VMInfo struct {
+	Name    string
+	ID      ids.ID
+	Aliases []string
+
+	Capabilities VMCapability
+	// CapabilitiesDeclared reports whether Capabilities was set with
+	// SetVMCapabilities, so that "none" can be told apart from "unknown".
+	CapabilitiesDeclared bool
+	APIRoutes            []string // relative to /ext/bc/<chain>
+}
+
+// VMRegistry maps VM IDs to human readable names and back. Custom VMs
+// installed under CustomVMDir register here so they show up by name.
+type VMRegistry struct {
+	mu     sync.RWMutex
+	byID   map[ids.ID]*VMInfo
+	byName map[string]*VMInfo // canonical names and aliases
+}
+
+// DefaultVMRegistry is the global VM registry, pre-populated with the
+// built-in VMs.
+var DefaultVMRegistry = NewVMRegistry()
+
+func init() {
+	builtins := []VMInfo{
+		{
+			Name:         PlatformVMName,
+			ID:           PlatformVMID,
+			Capabilities: VMCapabilityUTXO | VMCapabilityWarp | VMCapabilityStaking,
+			APIRoutes:    []string{VMRouteRoot},
+		},
+		{
+			Name:         XVMName,
+			ID:           XVMID,
+			Aliases:      []string{"avm"},
+			Capabilities: VMCapabilityUTXO,
+			APIRoutes:    []string{VMRouteRoot},
+		},
+		{
+			Name:         EVMName,
+			ID:           EVMID,
+			Capabilities: VMCapabilityEthRPC | VMCapabilityWarp | VMCapabilityStateSync,
+			APIRoutes:    []string{VMRouteRPC, VMRouteWS},
+		},
+		{
+			Name:         XSVMName,
+			ID:           XSVMID,
+			Capabilities: VMCapabilityWarp,
+			APIRoutes:    []string{VMRouteRoot},
+		},
+		{
+			Name:         BridgeVMName,
+			ID:           BridgeVMID,
+			Capabilities: VMCapabilityWarp,
+			APIRoutes:    []string{VMRouteRoot},
+		},
+		{
+			Name:         MPCVMName,
+			ID:           MPCVMID,
+			Capabilities: VMCapabilityWarp,
+			APIRoutes:    []string{VMRouteRoot},
+		},
+		{
+			Name:         OracleVMName,
+			ID:           OracleVMID,
+			Aliases:      []string{"ovm"},
+			Capabilities: VMCapabilityWarp,
+			APIRoutes:    []string{VMRouteRoot},
+		},
+		{
+			Name:         RelayVMName,
+			ID:           RelayVMID,
+			Aliases:      []string{"rvm"},
+			Capabilities: VMCapabilityWarp,
+			APIRoutes:    []string{VMRouteRoot},
+		},
+		// The remaining VMs only serve their JSON-RPC API.
+		{Name: QuantumVMName, ID: QuantumVMID, Aliases: []string{"qvm"}, APIRoutes: []string{VMRouteRoot}},
+		{Name: AIVMName, ID: AIVMID, APIRoutes: []string{VMRouteRoot}},
+		{Name: FHEVMName, ID: FHEVMID, APIRoutes: []string{VMRouteRoot}},
+		{Name: KeyVMName, ID: KeyVMID, Aliases: []string{"kvm"}, APIRoutes: []string{VMRouteRoot}},
+		{Name: ZKVMName, ID: ZKVMID, APIRoutes: []string{VMRouteRoot}},
+		{Name: GraphVMName, ID: GraphVMID, APIRoutes: []string{VMRouteRoot}},
+		{Name: DexVMName, ID: DexVMID, APIRoutes: []string{VMRouteRoot}},
+		{Name: IdentityVMName, ID: IdentityVMID, Aliases: []string{"ivm"}, APIRoutes: []string{VMRouteRoot}},
+	}
+	for _, vm := range builtins {
+		if err := DefaultVMRegistry.RegisterVM(vm.Name, vm.ID, vm.Aliases...); err != nil {
+			panic(err)
+		}
+		if err := DefaultVMRegistry.SetVMCapabilities(vm.ID, vm.Capabilities, vm.APIRoutes...); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// NewVMRegistry creates a new, empty VM registry.
+func NewVMRegistry() *VMRegistry {
+	return &VMRegistry{
+		byID:   make(map[ids.ID]*VMInfo),
+		byName: make(map[string]*VMInfo),
+	}
+}
+
+// RegisterVM registers [vmID] under [name] and any [aliases]. Names are
+// case-insensitive. Registering the same name, ID and aliases again is a
+// no-op; reusing a name or ID for a different VM is an error.
+func (r *VMRegistry) RegisterVM(name string, vmID ids.ID, aliases ...string) error {
+	name = strings.ToLower(name)
+	names := []string{name}
+	for _, alias := range aliases {
+		names = append(names, strings.ToLower(alias))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.byID[vmID]; ok && existing.Name != name {
+		return fmt.Errorf("%w: %s is already registered as %q", ErrVMAlreadyRegistered, vmID, existing.Name)
+	}
+	for _, n := range names {
+		if existing, ok := r.byName[n]; ok && existing.ID != vmID {
+			return fmt.Errorf("%w: %q is already registered to %s", ErrVMAlreadyRegistered, n, existing.ID)
+		}
+	}
+
+	info, ok := r.byID[vmID]
+	if !ok {
+		info = &VMInfo{Name: name, ID: vmID}
+		r.byID[vmID] = info
+		r.byName[name] = info
+	}
+	for _, alias := range names[1:] {
+		if _, ok := r.byName[alias]; ok {
+			continue
+		}
+		info.Aliases = append(info.Aliases, alias)
+		r.byName[alias] = info
+	}
+	return nil
+}
+
+// VMName returns the registered name of [vmID].
+func (r *VMRegistry) VMName(vmID ids.ID) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.byID[vmID]
+	if !ok {
+		return "", false
+	}
+	return info.Name, true
+}
+
+// VMIDFromName returns the ID of the VM registered under [name], which may
+// be a canonical name or an alias.
+func (r *VMRegistry) VMIDFromName(name string) (ids.ID, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.byName[strings.ToLower(name)]
+	if !ok {
+		return ids.Empty, false
+	}
+	return info.ID, true
+}
+
+// List returns every registered VM, sorted by name.
+func (r *VMRegistry) List() []VMInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	vms := make([]VMInfo, 0, len(r.byID))
+	for _, info := range r.byID {
+		vm := *info
+		vm.Aliases = slices.Clone(info.Aliases)
+		vm.APIRoutes = slices.Clone(info.APIRoutes)
+		vms = append(vms, vm)
+	}
+	slices.SortFunc(vms, func(a, b VMInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return vms
+}
+
+// Package-level convenience functions using DefaultVMRegistry
+
+// RegisterVM registers a VM with the DefaultVMRegistry.
+func RegisterVM(name string, vmID ids.ID, aliases ...string) error {
+	return DefaultVMRegistry.RegisterVM(name, vmID, aliases...)
+}
+
+// VMIDFromName returns the ID of the VM registered under [name] in the
+// DefaultVMRegistry.
+func VMIDFromName(name string) (ids.ID, bool) {
+	return DefaultVMRegistry.VMIDFromName(name)
+}
+
+// RegisteredVMs returns every VM in the DefaultVMRegistry, sorted by name.
+func RegisteredVMs() []VMInfo {
+	return DefaultVMRegistry.List()
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"testing"
+
+	"github.com/luxfi/ids"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVMNameBuiltins(t *testing.T) {
+	require := require.New(t)
+
+	for _, vm := range RegisteredVMs() {
+		require.Equal(vm.Name, VMName(vm.ID))
+
+		id, ok := VMIDFromName(vm.Name)
+		require.True(ok)
+		require.Equal(vm.ID, id)
+	}
+
+	id, ok := VMIDFromName("AVM")
+	require.True(ok)
+	require.Equal(XVMID, id)
+}
+
+func TestVMRegistry(t *testing.T) {
+	require := require.New(t)
+
+	r := NewVMRegistry()
+	vmID := ids.GenerateTestID()
+	require.NoError(r.RegisterVM("MyVM", vmID, "mine"))
+	require.NoError(r.RegisterVM("myvm", vmID, "mine")) // idempotent
+
+	name, ok := r.VMName(vmID)
+	require.True(ok)
+	require.Equal("myvm", name)
+
+	id, ok := r.VMIDFromName("mine")
+	require.True(ok)
+	require.Equal(vmID, id)
+
+	err := r.RegisterVM("other", vmID)
+	require.ErrorIs(err, ErrVMAlreadyRegistered)
+
+	err = r.RegisterVM("mine", ids.GenerateTestID())
+	require.ErrorIs(err, ErrVMAlreadyRegistered)
+
+	require.Equal([]VMInfo{{Name: "myvm", ID: vmID, Aliases: []string{"mine"}}}, r.List())
+
+	_, ok = r.VMName(ids.GenerateTestID())
+	require.False(ok)
+}
+
+func TestVMCapabilities(t *testing.T) {
+	require := require.New(t)
+
+	require.True(VMHasCapability(EVMID, VMCapabilityEthRPC|VMCapabilityWarp))
+	require.False(VMHasCapability(XVMID, VMCapabilityEthRPC))
+	require.True(VMHasCapability(PlatformVMID, VMCapabilityUTXO))
+	require.True(VMHasCapability(XVMID, 0))
+	require.False(VMHasCapability(ids.GenerateTestID(), 0))
+	require.Equal([]string{VMRouteRPC, VMRouteWS}, VMAPIRoutes(EVMID))
+	require.Equal("warp|eth-rpc|state-sync", (VMCapabilityEthRPC | VMCapabilityWarp | VMCapabilityStateSync).String())
+
+	r := NewVMRegistry()
+	vmID := ids.GenerateTestID()
+	require.ErrorIs(r.SetVMCapabilities(vmID, VMCapabilityWarp), ErrUnknownVM)
+
+	require.NoError(r.RegisterVM("myvm", vmID))
+	_, ok := r.VMCapabilities(vmID)
+	require.False(ok) // registered, but nothing declared yet
+	require.False(r.VMHasCapability(vmID, 0))
+
+	require.NoError(r.SetVMCapabilities(vmID, VMCapabilityWarp|VMCapabilityEthRPC, VMRouteRPC))
+	capabilities, ok := r.VMCapabilities(vmID)
+	require.True(ok)
+	require.True(capabilities.Has(VMCapabilityEthRPC))
+	require.Equal([]string{VMRouteRPC}, r.VMAPIRoutes(vmID))
+}
+
+func TestBuiltinVMCapabilitiesDeclared(t *testing.T) {
+	for _, vm := range RegisteredVMs() {
+		t.Run(vm.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			require.True(vm.CapabilitiesDeclared)
+			capabilities, ok := VMCapabilities(vm.ID)
+			require.True(ok)
+			require.Equal(vm.Capabilities, capabilities)
+			require.NotEmpty(VMAPIRoutes(vm.ID))
+		})
+	}
+}
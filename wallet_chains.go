@@ -0,0 +1,173 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// C-Chain native currency as presented to EVM wallets. The EVM side uses 18
+// decimals regardless of the 6-decimal UTXO denomination in units.go.
+const (
+	NativeCurrencyName        = "Lux"
+	NativeCurrencySymbol      = "LUX"
+	EVMNativeCurrencyDecimals = 18
+
+	// LuxInfoURL is the project homepage advertised in chain metadata.
+	LuxInfoURL = "https://lux.network"
+
+	// CChainRPCPath is the C-Chain JSON-RPC route relative to an API endpoint.
+	CChainRPCPath = "/ext/bc/C/rpc"
+)
+
+var (
+	// NetworkIDToAPIEndpoint maps a primary network to its public HTTP API.
+	NetworkIDToAPIEndpoint = map[NetworkID]string{
+		MainnetID: MainnetAPIEndpoint,
+		TestnetID: TestnetAPIEndpoint,
+		DevnetID:  DevnetAPIEndpoint,
+		LocalID:   LocalAPIEndpoint,
+	}
+
+	// ChainlistNetworkIDs are the networks published to chainlist.org.
+	ChainlistNetworkIDs = []NetworkID{MainnetID, TestnetID, DevnetID}
+)
+
+// NativeCurrency is the EIP-3085 nativeCurrency object.
+type NativeCurrency struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+}
+
+// AddEthereumChainParameter is the EIP-3085 wallet_addEthereumChain
+// parameter.
+//
+// See: https://eips.ethereum.org/EIPS/eip-3085
+type AddEthereumChainParameter struct {
+	ChainID           string         `json:"chainId"` // 0x-prefixed hex
+	ChainName         string         `json:"chainName"`
+	NativeCurrency    NativeCurrency `json:"nativeCurrency"`
+	RPCURLs           []string       `json:"rpcUrls"`
+	BlockExplorerURLs []string       `json:"blockExplorerUrls,omitempty"`
+	IconURLs          []string       `json:"iconUrls,omitempty"`
+}
+
+// ChainlistChain is a chain entry in the ethereum-lists/chains format used
+// by chainlist.org.
+//
+// See: https://github.com/ethereum-lists/chains
+type ChainlistChain struct {
+	Name           string         `json:"name"`
+	Chain          string         `json:"chain"`
+	RPC            []string       `json:"rpc"`
+	Faucets        []string       `json:"faucets"`
+	NativeCurrency NativeCurrency `json:"nativeCurrency"`
+	InfoURL        string         `json:"infoURL"`
+	ShortName      string         `json:"shortName"`
+	ChainID        EVMChainID     `json:"chainId"`
+	// NetworkID is the devp2p network ID, which equals the chain ID. It is
+	// not the P-Chain NetworkID.
+	NetworkID EVMChainID `json:"networkId"`
+}
+
+// WalletChainName returns the display name of the C-Chain on [networkID],
+// e.g. "Lux Mainnet".
+func WalletChainName(networkID NetworkID) string {
+	switch networkID {
+	case MainnetID:
+		return "Lux Mainnet"
+	case TestnetID:
+		return "Lux Testnet"
+	case DevnetID:
+		return "Lux Devnet"
+	case LocalID:
+		return "Lux Local"
+	default:
+		return "Lux " + NetworkName(networkID)
+	}
+}
+
+// CChainRPCURL returns the public C-Chain JSON-RPC URL of [networkID].
+func CChainRPCURL(networkID NetworkID) (string, bool) {
+	endpoint, ok := NetworkIDToAPIEndpoint[networkID]
+	if !ok {
+		return "", false
+	}
+	return endpoint + CChainRPCPath, true
+}
+
+// AddEthereumChainParams returns the wallet_addEthereumChain parameter for
+// the C-Chain of [networkID].
+func AddEthereumChainParams(networkID NetworkID) (AddEthereumChainParameter, error) {
+	chainID, rpcURL, err := walletChainInfo(networkID)
+	if err != nil {
+		return AddEthereumChainParameter{}, err
+	}
+	return AddEthereumChainParameter{
+		ChainID:        "0x" + strconv.FormatUint(uint64(chainID), 16),
+		ChainName:      WalletChainName(networkID),
+		NativeCurrency: nativeCurrency(),
+		RPCURLs:        []string{rpcURL},
+	}, nil
+}
+
+// ChainlistEntry returns the chainlist.org entry for the C-Chain of
+// [networkID].
+func ChainlistEntry(networkID NetworkID) (ChainlistChain, error) {
+	chainID, rpcURL, err := walletChainInfo(networkID)
+	if err != nil {
+		return ChainlistChain{}, err
+	}
+	shortName := PlatformName
+	if networkID != MainnetID {
+		shortName = NetworkAliasPrefix + NetworkName(networkID)
+	}
+	return ChainlistChain{
+		Name:           WalletChainName(networkID),
+		Chain:          NativeCurrencySymbol,
+		RPC:            []string{rpcURL},
+		Faucets:        []string{},
+		NativeCurrency: nativeCurrency(),
+		InfoURL:        LuxInfoURL,
+		ShortName:      shortName,
+		ChainID:        chainID,
+		NetworkID:      chainID,
+	}, nil
+}
+
+// ChainlistEntries returns the chainlist.org entries of every network in
+// ChainlistNetworkIDs.
+func ChainlistEntries() ([]ChainlistChain, error) {
+	entries := make([]ChainlistChain, 0, len(ChainlistNetworkIDs))
+	for _, networkID := range ChainlistNetworkIDs {
+		entry, err := ChainlistEntry(networkID)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func walletChainInfo(networkID NetworkID) (EVMChainID, string, error) {
+	chainID, ok := EVMChainIDForNetwork(networkID)
+	if !ok {
+		return 0, "", fmt.Errorf("%w: no C-Chain ID for %s", ErrNetworkNotFound, networkID)
+	}
+	rpcURL, ok := CChainRPCURL(networkID)
+	if !ok {
+		return 0, "", fmt.Errorf("%w: no API endpoint for %s", ErrNetworkNotFound, networkID)
+	}
+	return chainID, rpcURL, nil
+}
+
+func nativeCurrency() NativeCurrency {
+	return NativeCurrency{
+		Name:     NativeCurrencyName,
+		Symbol:   NativeCurrencySymbol,
+		Decimals: EVMNativeCurrencyDecimals,
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2025, Lux Industries, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package constants
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddEthereumChainParams(t *testing.T) {
+	require := require.New(t)
+
+	params, err := AddEthereumChainParams(MainnetID)
+	require.NoError(err)
+
+	b, err := json.Marshal(params)
+	require.NoError(err)
+	require.JSONEq(`{
+		"chainId": "0x17871",
+		"chainName": "Lux Mainnet",
+		"nativeCurrency": {"name": "Lux", "symbol": "LUX", "decimals": 18},
+		"rpcUrls": ["https://api.lux.network/ext/bc/C/rpc"]
+	}`, string(b))
+
+	_, err = AddEthereumChainParams(42)
+	require.ErrorIs(err, ErrNetworkNotFound)
+}
+
+func TestChainlistEntriesInSync(t *testing.T) {
+	require := require.New(t)
+
+	entries, err := ChainlistEntries()
+	require.NoError(err)
+	require.Len(entries, len(ChainlistNetworkIDs))
+
+	for i, networkID := range ChainlistNetworkIDs {
+		chainID, ok := EVMChainIDForNetwork(networkID)
+		require.True(ok)
+		require.Equal(chainID, entries[i].ChainID)
+		require.Equal(chainID, entries[i].NetworkID)
+
+		params, err := AddEthereumChainParams(networkID)
+		require.NoError(err)
+		require.Equal("0x"+strconv.FormatUint(uint64(chainID), 16), params.ChainID)
+		require.Equal(entries[i].RPC, params.RPCURLs)
+	}
+}